@@ -0,0 +1,104 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is written by hand. The Makefile lays it over the generated SDK.
+
+package statefulString
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// TriggersFromFiles returns triggers that change whenever the content of one of the
+// files changes. Each file maps to the SHA-256 of its content, so the content itself never
+// reaches the state. The key is the base name of the file with every character other
+// than a letter, digit, `_` or `-` replaced by `_`, so that it does not depend on where
+// the program runs; two files with the same key are an error.
+func TriggersFromFiles(paths ...string) (pulumi.Map, error) {
+	triggers := pulumi.Map{}
+	for _, path := range paths {
+		key := fileTriggerKey(path)
+		if _, ok := triggers[key]; ok {
+			return nil, fmt.Errorf("%s: another file already uses the trigger key %q", path, key)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		triggers[key] = pulumi.String(hex.EncodeToString(sum[:]))
+	}
+	return triggers, nil
+}
+
+var unsafeKeyChars = regexp.MustCompile(`[^A-Za-z0-9_-]`)
+
+func fileTriggerKey(path string) string {
+	return unsafeKeyChars.ReplaceAllString(filepath.Base(path), "_")
+}
+
+// TriggersFromOutputs returns triggers that change whenever one of the outputs resolves
+// to a new value.
+func TriggersFromOutputs(outputs map[string]pulumi.Input) pulumi.Map {
+	triggers := pulumi.Map{}
+	for key, output := range outputs {
+		triggers[key] = output
+	}
+	return triggers
+}
+
+// TriggerOnImageDigest returns a trigger that changes whenever the image is rebuilt. A
+// reference pinned by digest, such as `repo:tag@sha256:...`, triggers on its digest
+// alone; any other reference triggers on the whole reference.
+func TriggerOnImageDigest(image pulumi.StringInput) pulumi.Map {
+	return pulumi.Map{
+		"imageDigest": image.ToStringOutput().ApplyT(imageDigest).(pulumi.StringOutput),
+	}
+}
+
+func imageDigest(image string) string {
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		return image[i+1:]
+	}
+	return image
+}
+
+// MergeTriggers combines several sets of triggers into one. Later sets win when a key
+// appears more than once.
+func MergeTriggers(sets ...pulumi.Map) pulumi.Map {
+	triggers := pulumi.Map{}
+	for _, set := range sets {
+		for key, value := range set {
+			triggers[key] = value
+		}
+	}
+	return triggers
+}
+
+// Pinned is shorthand for a StatefulString that pins value until one of triggers changes.
+func Pinned(ctx *pulumi.Context, name string, value pulumi.StringInput, triggers pulumi.MapInput,
+	opts ...pulumi.ResourceOption) (*StatefulString, error) {
+	return NewStatefulString(ctx, name, &StatefulStringArgs{
+		String:   value.ToStringOutput().ToStringPtrOutput(),
+		Triggers: triggers,
+	}, opts...)
+}
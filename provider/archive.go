@@ -0,0 +1,89 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"regexp"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// Characters that may not appear in a store key.
+var archiveKeyUnsafe = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// archiveKey returns name in the alphabet of store keys, which doubles as the names of
+// the project and stack directories of the archive.
+func archiveKey(name string) string {
+	key := archiveKeyUnsafe.ReplaceAllString(name, "_")
+	if checkStoreKey(key) != nil {
+		key = "r" + key
+	}
+	return key
+}
+
+// urnKey holds the URN of the resource a request is about in its context.
+type urnKey struct{}
+
+// withURN records in ctx the URN of the resource a request is about, which typed
+// resource methods are not given.
+func withURN(ctx p.Context, urn resource.URN) p.Context {
+	return p.CtxWithValue(ctx, urnKey{}, urn)
+}
+
+// requestURN returns the URN recorded by withURN.
+func requestURN(ctx p.Context) resource.URN {
+	urn, _ := ctx.Value(urnKey{}).(resource.URN)
+	return urn
+}
+
+// Delete writes a tombstone of the pinned string to the archive when retainOnDelete is
+// set, so that a later resource can recover it with restoreFrom.
+func (ss StatefulString) Delete(ctx p.Context, id string, props StatefulStringState) error {
+	if !isTrue(props.RetainOnDelete) {
+		return nil
+	}
+	archive, err := getConfig(ctx).archive(requestURN(ctx))
+	if err != nil {
+		return err
+	}
+	key := archiveKey(id)
+	err = archive.Update(key, func(*SharedRecord) (*SharedRecord, error) {
+		return &SharedRecord{
+			Value:     props.String,
+			Triggers:  props.Triggers,
+			UpdatedAt: time.Now().UTC().Format(time.RFC3339),
+			Revision:  props.Revision,
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+	ctx.Logf(diag.Info, "%s: kept a tombstone of revision %d as %q", id, props.Revision, key)
+	return nil
+}
+
+// restoreTombstone reads the tombstone of the resource args.RestoreFrom names, in the
+// same project and stack. ok is false when there is none, in which case a new string is
+// pinned as usual.
+func restoreTombstone(ctx p.Context, args StatefulStringArgs) (record SharedRecord, ok bool, err error) {
+	archive, err := getConfig(ctx).archive(requestURN(ctx))
+	if err != nil {
+		return SharedRecord{}, false, err
+	}
+	return archive.Get(archiveKey(*args.RestoreFrom))
+}
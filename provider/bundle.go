@@ -0,0 +1,253 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// The token of the StatefulString a SecretBundle pins its value with.
+const statefulStringToken = "statefulString:index:StatefulString"
+
+// SecretBundle is a component that pins a generated value with a StatefulString and
+// derives the forms programs need from it. Every derived output follows the pinned value,
+// so they all rotate together when one of the triggers changes.
+type SecretBundle struct{}
+
+// A BundleVariant is another form of the pinned value, made of `length` characters from
+// `charset`, such as one without symbols for a system that rejects them.
+type BundleVariant struct {
+	Length  *int    `pulumi:"length,optional"`
+	Charset *string `pulumi:"charset,optional"`
+}
+
+type SecretBundleArgs struct {
+	Triggers     pulumi.MapInput          `pulumi:"triggers,optional"`
+	Generator    *Generator               `pulumi:"generator,optional"`
+	Variants     map[string]BundleVariant `pulumi:"variants,optional"`
+	ExpiresAfter pulumi.StringPtrInput    `pulumi:"expiresAfter,optional"`
+	Hashes       *HashOptions             `pulumi:"hashes,optional"`
+}
+
+type SecretBundleState struct {
+	pulumi.ResourceState
+
+	// Value is the pinned value and Variants its other forms, by name.
+	Value    pulumi.StringOutput    `pulumi:"value" provider:"secret"`
+	Variants pulumi.StringMapOutput `pulumi:"variants" provider:"secret"`
+	// Sha256 is the hex SHA-256 digest of the pinned value, which is as sensitive as the
+	// value itself because it is unsalted.
+	Sha256 pulumi.StringOutput `pulumi:"sha256" provider:"secret"`
+	// ExpiresAt and Revision record when the pinned value expires and how many values
+	// have been pinned.
+	ExpiresAt pulumi.StringPtrOutput `pulumi:"expiresAt,optional"`
+	Revision  pulumi.IntOutput       `pulumi:"revision"`
+	// The hashes of the pinned value selected by the hashes input.
+	BcryptHash pulumi.StringPtrOutput `pulumi:"bcryptHash,optional" provider:"secret"`
+	Argon2Hash pulumi.StringPtrOutput `pulumi:"argon2Hash,optional" provider:"secret"`
+	ScryptHash pulumi.StringPtrOutput `pulumi:"scryptHash,optional" provider:"secret"`
+}
+
+func (b *SecretBundle) Annotate(a infer.Annotator) {
+	a.Describe(&b, "A generated value pinned by a StatefulString, together with the forms programs "+
+		"derive from it. Every output rotates with the pinned value.")
+}
+
+func (v *BundleVariant) Annotate(a infer.Annotator) {
+	a.Describe(&v.Length, "How many characters the variant has. Defaults to 32.")
+	a.Describe(&v.Charset, "The characters the variant is made of. Defaults to letters and digits.")
+}
+
+func (a *SecretBundleArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Triggers, "Arbitrary values that rotate the value, and everything derived from it, "+
+		"whenever any of them changes.")
+	an.Describe(&a.Generator, "How to generate the pinned value. Defaults to 32 random letters and digits.")
+	an.Describe(&a.Variants, "Other forms of the pinned value to derive, by name. A variant only changes "+
+		"when the pinned value does.")
+	an.Describe(&a.ExpiresAfter, "How long a pinned value lives, such as `720h`.")
+	an.Describe(&a.Hashes, "The salted hashes of the pinned value to compute.")
+}
+
+func (s *SecretBundleState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Value, "The pinned value.")
+	a.Describe(&s.Variants, "The variants of the pinned value, by name.")
+	a.Describe(&s.Sha256, "The hex SHA-256 digest of the pinned value.")
+	a.Describe(&s.ExpiresAt, "The RFC 3339 time at which the pinned value expires.")
+	a.Describe(&s.Revision, "How many values have been pinned, starting from 1.")
+	a.Describe(&s.BcryptHash, "The bcrypt hash of the pinned value, when `hashes.bcrypt` is set.")
+	a.Describe(&s.Argon2Hash, "The argon2id hash of the pinned value, when `hashes.argon2` is set.")
+	a.Describe(&s.ScryptHash, "The scrypt hash of the pinned value, when `hashes.scrypt` is set.")
+}
+
+// pinnedSecretOutputs are the outputs of a StatefulString that hold the pinned value or a
+// form of it. The bundle asks for them to be secret whatever the provider reports.
+var pinnedSecretOutputs = []string{"string", "result", "current", "desiredString", "previous", "pending"}
+
+// pinnedString is the part of a StatefulString a SecretBundle reads back.
+type pinnedString struct {
+	pulumi.CustomResourceState
+
+	String    pulumi.StringOutput    `pulumi:"string"`
+	ExpiresAt pulumi.StringPtrOutput `pulumi:"expiresAt"`
+	Revision  pulumi.IntOutput       `pulumi:"revision"`
+
+	BcryptHash pulumi.StringPtrOutput `pulumi:"bcryptHash"`
+	Argon2Hash pulumi.StringPtrOutput `pulumi:"argon2Hash"`
+	ScryptHash pulumi.StringPtrOutput `pulumi:"scryptHash"`
+}
+
+func (SecretBundle) Construct(ctx *pulumi.Context, name, typ string, args SecretBundleArgs,
+	opts pulumi.ResourceOption) (*SecretBundleState, error) {
+	comp := &SecretBundleState{}
+	if err := ctx.RegisterComponentResource(typ, name, comp, opts); err != nil {
+		return nil, err
+	}
+
+	generator := Generator{Kind: GeneratorRandom}
+	if args.Generator != nil {
+		generator = *args.Generator
+	}
+	if err := generator.validate(); err != nil {
+		return nil, fmt.Errorf("generator: %w", err)
+	}
+	// Only a derive generator needs the seed, which is part of the provider's config
+	if generator.Kind == GeneratorDerive {
+		seed := getConfig(infer.CtxFromPulumiContext(ctx)).DeriveSeed
+		if err := generator.checkSeed(seed); err != nil {
+			return nil, fmt.Errorf("generator: %w", err)
+		}
+	}
+	for _, variant := range sortedVariantNames(args.Variants) {
+		if err := args.Variants[variant].generator(new(string)).validate(); err != nil {
+			return nil, fmt.Errorf("variant %q: %w", variant, err)
+		}
+	}
+
+	inputs := pulumi.Map{"generator": generatorInputs(generator)}
+	if args.Triggers != nil {
+		inputs["triggers"] = args.Triggers
+	}
+	if args.ExpiresAfter != nil {
+		inputs["expiresAfter"] = args.ExpiresAfter
+	}
+	if args.Hashes != nil {
+		inputs["hashes"] = hashInputs(*args.Hashes)
+	}
+	var pinned pinnedString
+	if err := ctx.RegisterResource(statefulStringToken, name, inputs, &pinned, pulumi.Parent(comp),
+		pulumi.AdditionalSecretOutputs(pinnedSecretOutputs)); err != nil {
+		return nil, err
+	}
+
+	comp.Value = pulumi.ToSecret(pinned.String).(pulumi.StringOutput)
+	comp.Variants = pulumi.ToSecret(pinned.String.ApplyT(func(value string) (map[string]string, error) {
+		return bundleVariants(value, args.Variants)
+	})).(pulumi.StringMapOutput)
+	comp.Sha256 = pulumi.ToSecret(pinned.String.ApplyT(func(value string) string {
+		sum := sha256.Sum256([]byte(value))
+		return hex.EncodeToString(sum[:])
+	})).(pulumi.StringOutput)
+	comp.ExpiresAt = pinned.ExpiresAt
+	comp.Revision = pinned.Revision
+	comp.BcryptHash = pinned.BcryptHash
+	comp.Argon2Hash = pinned.Argon2Hash
+	comp.ScryptHash = pinned.ScryptHash
+	return comp, nil
+}
+
+// generator returns the generator that draws the variant, seeded with the pinned value so
+// that the variant only changes when the pinned value does.
+func (v BundleVariant) generator(pinned *string) Generator {
+	return Generator{Kind: GeneratorRandom, Length: v.Length, Charset: v.Charset, Seed: pinned}
+}
+
+// bundleVariants derives every variant of the pinned value. Each variant draws from its
+// own stream, so variants with the same settings still differ.
+func bundleVariants(pinned string, variants map[string]BundleVariant) (map[string]string, error) {
+	values := make(map[string]string, len(variants))
+	for name, v := range variants {
+		value, err := v.generator(&pinned).generate(generatorInput{label: "variant." + name})
+		if err != nil {
+			return nil, fmt.Errorf("variant %q: %w", name, err)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+// generatorInputs encodes g as the generator input of a StatefulString.
+func generatorInputs(g Generator) pulumi.Map {
+	inputs := pulumi.Map{"kind": pulumi.String(g.Kind)}
+	if g.Length != nil {
+		inputs["length"] = pulumi.Int(*g.Length)
+	}
+	if g.Charset != nil {
+		inputs["charset"] = pulumi.String(*g.Charset)
+	}
+	if g.Words != nil {
+		inputs["words"] = pulumi.Int(*g.Words)
+	}
+	if g.Separator != nil {
+		inputs["separator"] = pulumi.String(*g.Separator)
+	}
+	if g.MaxLength != nil {
+		inputs["maxLength"] = pulumi.Int(*g.MaxLength)
+	}
+	if g.Seed != nil {
+		inputs["seed"] = pulumi.String(*g.Seed)
+	}
+	return inputs
+}
+
+// hashInputs encodes h as the hashes input of a StatefulString.
+func hashInputs(h HashOptions) pulumi.Map {
+	ints := func(fields map[string]*int) pulumi.Map {
+		m := pulumi.Map{}
+		for k, v := range fields {
+			if v != nil {
+				m[k] = pulumi.Int(*v)
+			}
+		}
+		return m
+	}
+	inputs := pulumi.Map{}
+	if h.Bcrypt != nil {
+		inputs["bcrypt"] = ints(map[string]*int{"cost": h.Bcrypt.Cost})
+	}
+	if h.Argon2 != nil {
+		inputs["argon2"] = ints(map[string]*int{
+			"time": h.Argon2.Time, "memory": h.Argon2.Memory, "threads": h.Argon2.Threads,
+		})
+	}
+	if h.Scrypt != nil {
+		inputs["scrypt"] = ints(map[string]*int{"logN": h.Scrypt.LogN, "r": h.Scrypt.R, "p": h.Scrypt.P})
+	}
+	return inputs
+}
+
+func sortedVariantNames(variants map[string]BundleVariant) []string {
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,387 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"slices"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+const (
+	// How long a certificate is valid for, and how long before its notAfter it is renewed,
+	// when neither is given.
+	defaultCertValidFor    = "8760h"
+	defaultCertRenewBefore = "720h"
+)
+
+// StatefulCertificate pins a self-signed or locally-CA-signed certificate, such as one for
+// a development environment, until its contents or triggers change or it is due for
+// renewal.
+type StatefulCertificate struct{}
+
+type CertificateSubject struct {
+	CommonName   string  `pulumi:"commonName"`
+	Organization *string `pulumi:"organization,optional"`
+}
+
+type StatefulCertificateArgs struct {
+	Subject     CertificateSubject `pulumi:"subject"`
+	DNSNames    []string           `pulumi:"dnsNames,optional"`
+	IPAddresses []string           `pulumi:"ipAddresses,optional"`
+	IsCA        *bool              `pulumi:"isCa,optional"`
+	// The key settings of the certificate, as for a StatefulKeyPair.
+	KeyAlgorithm *KeyAlgorithm `pulumi:"keyAlgorithm,optional"`
+	RSABits      *int          `pulumi:"rsaBits,optional"`
+	ECDSACurve   *ECDSACurve   `pulumi:"ecdsaCurve,optional"`
+	// ValidFor and RenewBefore are durations, such as `720h`.
+	ValidFor    *string `pulumi:"validFor,optional"`
+	RenewBefore *string `pulumi:"renewBefore,optional"`
+	// The CA that signs the certificate. Without one, the certificate is self-signed.
+	CACertPem       *string        `pulumi:"caCertPem,optional"`
+	CAPrivateKeyPem *string        `pulumi:"caPrivateKeyPem,optional" provider:"secret"`
+	Triggers        map[string]any `pulumi:"triggers,optional"`
+}
+
+type StatefulCertificateState struct {
+	StatefulCertificateArgs
+	CertPem       string `pulumi:"certPem"`
+	PrivateKeyPem string `pulumi:"privateKeyPem" provider:"secret"`
+	// FingerprintSha256 is the hex SHA-256 digest of the DER certificate.
+	FingerprintSha256 string `pulumi:"fingerprintSha256"`
+	// NotBefore and NotAfter are the RFC 3339 bounds of the validity period.
+	NotBefore string `pulumi:"notBefore"`
+	NotAfter  string `pulumi:"notAfter"`
+}
+
+func (c *StatefulCertificate) Annotate(a infer.Annotator) {
+	a.Describe(&c, "A self-signed or locally-CA-signed certificate that stays pinned until its contents "+
+		"or triggers change, or until it is within `renewBefore` of expiring.")
+}
+
+func (s *CertificateSubject) Annotate(a infer.Annotator) {
+	a.Describe(&s.CommonName, "The common name (CN) of the subject.")
+	a.Describe(&s.Organization, "The organization (O) of the subject.")
+}
+
+func (a *StatefulCertificateArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Subject, "The subject of the certificate.")
+	an.Describe(&a.DNSNames, "The DNS names the certificate is valid for.")
+	an.Describe(&a.IPAddresses, "The IP addresses the certificate is valid for.")
+	an.Describe(&a.IsCA, "Whether the certificate can sign other certificates, for use as a local CA.")
+	an.Describe(&a.KeyAlgorithm, "The kind of key to generate. Defaults to `ecdsa`.")
+	an.Describe(&a.RSABits, "The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.")
+	an.Describe(&a.ECDSACurve, "The curve of an ECDSA key. Defaults to `P256`.")
+	an.Describe(&a.ValidFor, "How long the certificate is valid for, such as `720h`. Defaults to `8760h`.")
+	an.Describe(&a.RenewBefore, "How long before `notAfter` the certificate is renewed. Defaults to `720h`.")
+	an.Describe(&a.CACertPem, "The PEM certificate of the CA that signs the certificate. Without it, "+
+		"the certificate is self-signed.")
+	an.Describe(&a.CAPrivateKeyPem, "The PEM private key of the CA that signs the certificate.")
+	an.Describe(&a.Triggers, "Arbitrary values that renew the certificate whenever any of them is "+
+		"added, removed or changed.")
+}
+
+func (s *StatefulCertificateState) Annotate(a infer.Annotator) {
+	a.Describe(&s.CertPem, "The certificate in PEM format.")
+	a.Describe(&s.PrivateKeyPem, "The private key of the certificate in PKCS #8 PEM format.")
+	a.Describe(&s.FingerprintSha256, "The hex SHA-256 digest of the DER certificate.")
+	a.Describe(&s.NotBefore, "The RFC 3339 time from which the certificate is valid.")
+	a.Describe(&s.NotAfter, "The RFC 3339 time at which the certificate expires.")
+}
+
+// WireDependencies keeps the default wiring, where every output depends on every input,
+// and additionally always treats the private keys, including the CA key echoed back from
+// the inputs, as secret.
+func (c StatefulCertificate) WireDependencies(f infer.FieldSelector, args *StatefulCertificateArgs, state *StatefulCertificateState) {
+	f.OutputField(state).DependsOn(f.InputField(args))
+	f.OutputField(&state.PrivateKeyPem).AlwaysSecret()
+	f.OutputField(&state.CAPrivateKeyPem).AlwaysSecret()
+}
+
+func (c StatefulCertificate) Check(ctx p.Context, name string, olds resource.PropertyMap, news resource.PropertyMap) (StatefulCertificateArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[StatefulCertificateArgs](news)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+
+	failures = append(failures, args.keyArgs().check()...)
+	for _, ip := range args.IPAddresses {
+		if net.ParseIP(ip) == nil {
+			failures = append(failures, p.CheckFailure{
+				Property: "ipAddresses",
+				Reason:   fmt.Sprintf("%q is not an IP address", ip),
+			})
+		}
+	}
+	validFor, validErr := args.validFor()
+	if validErr != nil {
+		failures = append(failures, p.CheckFailure{Property: "validFor", Reason: validErr.Error()})
+	}
+	renewBefore, renewErr := args.renewBefore()
+	if renewErr != nil {
+		failures = append(failures, p.CheckFailure{Property: "renewBefore", Reason: renewErr.Error()})
+	}
+	if validErr == nil && renewErr == nil && renewBefore >= validFor {
+		// Such a certificate would be due for renewal as soon as it is created
+		failures = append(failures, p.CheckFailure{
+			Property: "renewBefore",
+			Reason:   fmt.Sprintf("renewBefore must be shorter than validFor (%s)", validFor),
+		})
+	}
+	if (args.CACertPem == nil) != (args.CAPrivateKeyPem == nil) {
+		failures = append(failures, p.CheckFailure{
+			Property: "caCertPem",
+			Reason:   "caCertPem and caPrivateKeyPem must be set together",
+		})
+	}
+	return args, failures, nil
+}
+
+// keyArgs returns the key settings of a as those of a StatefulKeyPair.
+func (a StatefulCertificateArgs) keyArgs() StatefulKeyPairArgs {
+	algorithm := KeyECDSA
+	if a.KeyAlgorithm != nil {
+		algorithm = *a.KeyAlgorithm
+	}
+	return StatefulKeyPairArgs{Algorithm: algorithm, RSABits: a.RSABits, ECDSACurve: a.ECDSACurve}
+}
+
+func (a StatefulCertificateArgs) validFor() (time.Duration, error) {
+	return positiveDuration(a.ValidFor, defaultCertValidFor)
+}
+
+func (a StatefulCertificateArgs) renewBefore() (time.Duration, error) {
+	return positiveDuration(a.RenewBefore, defaultCertRenewBefore)
+}
+
+func positiveDuration(value *string, def string) (time.Duration, error) {
+	if value == nil {
+		value = &def
+	}
+	d, err := time.ParseDuration(*value)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, errors.New("duration must be positive")
+	}
+	return d, nil
+}
+
+func (c StatefulCertificate) Create(ctx p.Context, name string, input StatefulCertificateArgs, preview bool) (id string, output StatefulCertificateState, err error) {
+	output = StatefulCertificateState{StatefulCertificateArgs: input}
+	// Previews never generate, so the certificate is unknown until the update runs
+	if !preview {
+		output, err = issueCertificate(input, time.Now())
+	}
+	return name, output, err
+}
+
+func (c StatefulCertificate) Diff(ctx p.Context, name string, olds StatefulCertificateState, news StatefulCertificateArgs) (p.DiffResponse, error) {
+	changeMap := renewal(olds, news, time.Now())
+	// A new renewal window only moves the next renewal, so Update keeps the certificate
+	if !ptrEqual(olds.RenewBefore, news.RenewBefore) {
+		changeMap["renewBefore"] = p.PropertyDiff{Kind: p.DiffKind("update"), InputDiff: false}
+	}
+	return p.DiffResponse{
+		HasChanges:   len(changeMap) > 0,
+		DetailedDiff: changeMap,
+	}, nil
+}
+
+func (c StatefulCertificate) Update(ctx p.Context, name string, olds StatefulCertificateState, news StatefulCertificateArgs, preview bool) (StatefulCertificateState, error) {
+	now := time.Now()
+	if len(renewal(olds, news, now)) == 0 {
+		// Keep the pinned certificate; only the inputs that do not shape it change
+		output := olds
+		output.RenewBefore = news.RenewBefore
+		output.Triggers = news.Triggers
+		return output, nil
+	}
+	if preview {
+		return StatefulCertificateState{StatefulCertificateArgs: news}, nil
+	}
+	return issueCertificate(news, now)
+}
+
+// renewal returns why the certificate pinned in olds must be renewed at now, as diff
+// entries. It is empty when the certificate is kept.
+func renewal(olds StatefulCertificateState, news StatefulCertificateArgs, now time.Time) map[string]p.PropertyDiff {
+	changeMap := map[string]p.PropertyDiff{}
+	changed := func(property string, same bool) {
+		if !same {
+			changeMap[property] = p.PropertyDiff{Kind: p.DiffKind("update"), InputDiff: false}
+		}
+	}
+	// Everything the certificate is made from renews it, and is reported like a trigger
+	changed("subject.commonName", olds.Subject.CommonName == news.Subject.CommonName)
+	changed("subject.organization", ptrEqual(olds.Subject.Organization, news.Subject.Organization))
+	changed("dnsNames", slices.Equal(olds.DNSNames, news.DNSNames))
+	changed("ipAddresses", slices.Equal(olds.IPAddresses, news.IPAddresses))
+	changed("isCa", ptrEqual(olds.IsCA, news.IsCA))
+	changed("keyAlgorithm", olds.keyArgs().Algorithm == news.keyArgs().Algorithm)
+	changed("rsaBits", ptrEqual(olds.RSABits, news.RSABits))
+	changed("ecdsaCurve", ptrEqual(olds.ECDSACurve, news.ECDSACurve))
+	changed("validFor", ptrEqual(olds.ValidFor, news.ValidFor))
+	changed("caCertPem", ptrEqual(olds.CACertPem, news.CACertPem))
+	changed("caPrivateKeyPem", ptrEqual(olds.CAPrivateKeyPem, news.CAPrivateKeyPem))
+	diffTriggers(olds.Triggers, news.Triggers, changeMap)
+
+	if len(changeMap) == 0 && dueForRenewal(olds, news, now) {
+		changeMap["notAfter"] = p.PropertyDiff{Kind: p.DiffKind("update"), InputDiff: false}
+	}
+	return changeMap
+}
+
+// dueForRenewal reports whether the certificate pinned in olds is within the renewal
+// window of news at now.
+func dueForRenewal(olds StatefulCertificateState, news StatefulCertificateArgs, now time.Time) bool {
+	notAfter, err := time.Parse(time.RFC3339, olds.NotAfter)
+	if err != nil {
+		return false
+	}
+	renewBefore, err := news.renewBefore()
+	if err != nil {
+		return false
+	}
+	return !now.Before(notAfter.Add(-renewBefore))
+}
+
+// issueCertificate generates a new key and issues a certificate for it at now, as args
+// describe.
+func issueCertificate(args StatefulCertificateArgs, now time.Time) (StatefulCertificateState, error) {
+	validFor, err := args.validFor()
+	if err != nil {
+		return StatefulCertificateState{}, fmt.Errorf("validFor: %w", err)
+	}
+	key, err := args.keyArgs().generateKey()
+	if err != nil {
+		return StatefulCertificateState{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return StatefulCertificateState{}, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: args.Subject.CommonName},
+		NotBefore:    now.UTC().Truncate(time.Second),
+		NotAfter:     now.Add(validFor).UTC().Truncate(time.Second),
+		DNSNames:     args.DNSNames,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+
+		BasicConstraintsValid: true,
+	}
+	if args.Subject.Organization != nil {
+		template.Subject.Organization = []string{*args.Subject.Organization}
+	}
+	for _, ip := range args.IPAddresses {
+		parsed := net.ParseIP(ip)
+		if parsed == nil {
+			return StatefulCertificateState{}, fmt.Errorf("%q is not an IP address", ip)
+		}
+		template.IPAddresses = append(template.IPAddresses, parsed)
+	}
+	if args.keyArgs().Algorithm == KeyRSA {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+	if args.IsCA != nil && *args.IsCA {
+		template.IsCA = true
+		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+
+	// Without a CA the certificate signs itself
+	parent, signer := template, key
+	if args.CACertPem != nil && args.CAPrivateKeyPem != nil {
+		if parent, signer, err = parseCA(*args.CACertPem, *args.CAPrivateKeyPem); err != nil {
+			return StatefulCertificateState{}, err
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	if err != nil {
+		return StatefulCertificateState{}, err
+	}
+	private, err := privateKeyPem(key)
+	if err != nil {
+		return StatefulCertificateState{}, err
+	}
+	sum := sha256.Sum256(der)
+	return StatefulCertificateState{
+		StatefulCertificateArgs: args,
+		CertPem:                 string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKeyPem:           private,
+		FingerprintSha256:       hex.EncodeToString(sum[:]),
+		NotBefore:               template.NotBefore.Format(time.RFC3339),
+		NotAfter:                template.NotAfter.Format(time.RFC3339),
+	}, nil
+}
+
+// parseCA parses the certificate and private key of a CA, checking that they belong
+// together and that the certificate may sign others.
+func parseCA(certPem, keyPem string) (*x509.Certificate, crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(certPem))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, nil, errors.New("caCertPem: no PEM certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("caCertPem: %w", err)
+	}
+	if !cert.IsCA {
+		return nil, nil, errors.New("caCertPem: the certificate is not a CA")
+	}
+
+	block, _ = pem.Decode([]byte(keyPem))
+	if block == nil {
+		return nil, nil, errors.New("caPrivateKeyPem: no PEM private key found")
+	}
+	var key any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("caPrivateKeyPem: %w", err)
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, nil, errors.New("caPrivateKeyPem: the key cannot sign")
+	}
+	public, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !public.Equal(cert.PublicKey) {
+		return nil, nil, errors.New("caPrivateKeyPem: the key does not match caCertPem")
+	}
+	return cert, signer, nil
+}
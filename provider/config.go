@@ -0,0 +1,87 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// Config is the provider level configuration, set with `pulumi config set statefulString:<key>`.
+type Config struct {
+	// SharedStoreDir is the directory SharedStatefulString records are kept in. It
+	// defaults to ~/.pulumi-statefulstring/shared so every stack on a machine shares it.
+	SharedStoreDir *string `pulumi:"sharedStoreDir,optional"`
+	// RotationManifest is the path of the JSON file rotation groups are read from.
+	RotationManifest *string `pulumi:"rotationManifest,optional"`
+	// ArchiveDir is the directory StatefulString tombstones are kept in, in a directory per
+	// project and stack. It defaults to ~/.pulumi-statefulstring/archive.
+	ArchiveDir *string `pulumi:"archiveDir,optional"`
+	// DeriveSeed keys the derive generator. Every stack given the same seed derives the
+	// same values from the same triggers, so it must be kept as secret as those values.
+	DeriveSeed *string `pulumi:"deriveSeed,optional" provider:"secret"`
+}
+
+func (c *Config) Annotate(a infer.Annotator) {
+	a.Describe(&c.SharedStoreDir, "The directory shared strings are kept in. Defaults to "+
+		"`~/.pulumi-statefulstring/shared`.")
+	a.Describe(&c.RotationManifest, "The path of the JSON file rotation groups are read from.")
+	a.Describe(&c.ArchiveDir, "The directory tombstones of deleted strings are kept in, in a directory "+
+		"per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.")
+	a.Describe(&c.DeriveSeed, "The secret that keys the `derive` generator. The same seed and triggers "+
+		"always derive the same value.")
+}
+
+// sharedStore returns the store SharedStatefulString resources and invokes use.
+func (c Config) sharedStore() (Store, error) {
+	if c.SharedStoreDir != nil {
+		return NewFileStore(*c.SharedStoreDir), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, err
+	}
+	return NewFileStore(filepath.Join(home, ".pulumi-statefulstring", "shared")), nil
+}
+
+// archive returns the store the tombstones of the project and stack of urn are written
+// to and restored from. Each project and stack has a directory of its own, so that
+// resources of the same name in other stacks sharing the archive keep their own tombstones.
+func (c Config) archive(urn resource.URN) (Store, error) {
+	var dir string
+	if c.ArchiveDir != nil {
+		dir = *c.ArchiveDir
+	} else {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(home, ".pulumi-statefulstring", "archive")
+	}
+	if urn.IsValid() {
+		dir = filepath.Join(dir, archiveKey(string(urn.Project())), archiveKey(string(urn.Stack())))
+	}
+	return NewFileStore(dir), nil
+}
+
+// getConfig returns the provider configuration, or the zero Config when the provider
+// has not been configured.
+func getConfig(ctx p.Context) Config {
+	return infer.GetConfig[Config](ctx)
+}
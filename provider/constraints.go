@@ -0,0 +1,83 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// checkConstraints validates the string that is about to be pinned against the
+// constraint inputs of args. Invalid constraints are reported against the constraint
+// itself, so a bad pattern is never blamed on the value.
+func checkConstraints(args StatefulStringArgs) []p.CheckFailure {
+	failures := []p.CheckFailure{}
+	fail := func(property, format string, a ...any) {
+		failures = append(failures, p.CheckFailure{
+			Property: property,
+			Reason:   fmt.Sprintf(format, a...),
+		})
+	}
+	value := args.String
+	length := utf8.RuneCountInString(value)
+
+	if args.Pattern != nil {
+		re, err := regexp.Compile(*args.Pattern)
+		if err != nil {
+			fail("pattern", "invalid regular expression: %s", err)
+		} else if !re.MatchString(value) {
+			fail("string", "value does not match pattern %q", *args.Pattern)
+		}
+	}
+
+	if args.MinLength != nil && args.MaxLength != nil && *args.MinLength > *args.MaxLength {
+		fail("minLength", "minLength (%d) is greater than maxLength (%d)", *args.MinLength, *args.MaxLength)
+	} else {
+		if args.MinLength != nil && length < *args.MinLength {
+			fail("string", "value is %d characters long, shorter than minLength %d", length, *args.MinLength)
+		}
+		if args.MaxLength != nil && length > *args.MaxLength {
+			fail("string", "value is %d characters long, longer than maxLength %d", length, *args.MaxLength)
+		}
+	}
+
+	if len(args.AllowedValues) > 0 {
+		allowed := false
+		for _, v := range args.AllowedValues {
+			if v == value {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			fail("string", "value is not one of the allowed values: %s", strings.Join(args.AllowedValues, ", "))
+		}
+	}
+
+	if args.Charset != nil {
+		re, err := regexp.Compile("^[" + *args.Charset + "]*$")
+		if err != nil {
+			fail("charset", "invalid character class %q: %s", *args.Charset, err)
+		} else if !re.MatchString(value) {
+			fail("string", "value contains characters outside of charset [%s]", *args.Charset)
+		}
+	}
+
+	return failures
+}
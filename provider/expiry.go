@@ -0,0 +1,119 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// ExpiryPolicy decides what happens once a pinned string is past its expiresAt.
+type ExpiryPolicy string
+
+const (
+	ExpiryWarn   ExpiryPolicy = "warn"
+	ExpiryFail   ExpiryPolicy = "fail"
+	ExpiryRotate ExpiryPolicy = "rotate"
+)
+
+func (ExpiryPolicy) Values() []infer.EnumValue[ExpiryPolicy] {
+	return []infer.EnumValue[ExpiryPolicy]{
+		{Name: "Warn", Value: ExpiryWarn, Description: "Emit a warning on every diff. This is the default."},
+		{Name: "Fail", Value: ExpiryFail, Description: "Fail the update until the string is rotated."},
+		{Name: "Rotate", Value: ExpiryRotate, Description: "Pin the current string input as if a trigger changed."},
+	}
+}
+
+// onExpiry returns the configured expiry policy, defaulting to a warning.
+func (a StatefulStringArgs) onExpiry() ExpiryPolicy {
+	if a.OnExpiry == nil {
+		return ExpiryWarn
+	}
+	return *a.OnExpiry
+}
+
+// checkExpiry validates the expiry inputs of args.
+func checkExpiry(args StatefulStringArgs) []p.CheckFailure {
+	failures := []p.CheckFailure{}
+	duration := func(property string, value *string) {
+		if value == nil {
+			return
+		}
+		if d, err := time.ParseDuration(*value); err != nil {
+			failures = append(failures, p.CheckFailure{Property: property, Reason: err.Error()})
+		} else if d <= 0 {
+			failures = append(failures, p.CheckFailure{Property: property, Reason: "duration must be positive"})
+		}
+	}
+	duration("expiresAfter", args.ExpiresAfter)
+	duration("warnBefore", args.WarnBefore)
+
+	if args.ExpiresAfter == nil {
+		if args.WarnBefore != nil {
+			failures = append(failures, p.CheckFailure{Property: "warnBefore", Reason: "warnBefore requires expiresAfter"})
+		}
+		if args.OnExpiry != nil {
+			failures = append(failures, p.CheckFailure{Property: "onExpiry", Reason: "onExpiry requires expiresAfter"})
+		}
+	}
+	return failures
+}
+
+// expiresAt computes the expiry of a string pinned at now, or nil when the string never expires.
+func expiresAt(args StatefulStringArgs, now time.Time) (*string, error) {
+	if args.ExpiresAfter == nil {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(*args.ExpiresAfter)
+	if err != nil {
+		return nil, fmt.Errorf("expiresAfter: %w", err)
+	}
+	at := now.Add(d).UTC().Format(time.RFC3339)
+	return &at, nil
+}
+
+// An expiryStatus describes how close the pinned string in some state is to expiring.
+type expiryStatus struct {
+	at        time.Time
+	expired   bool
+	warn      bool
+	remaining time.Duration
+}
+
+// checkExpiryStatus reports the expiry status of the string pinned in olds at now, using
+// the warning window of news. ok is false when the pinned string does not expire.
+func checkExpiryStatus(olds StatefulStringState, news StatefulStringArgs, now time.Time) (status expiryStatus, ok bool) {
+	if olds.ExpiresAt == nil {
+		return expiryStatus{}, false
+	}
+	at, err := time.Parse(time.RFC3339, *olds.ExpiresAt)
+	if err != nil {
+		return expiryStatus{}, false
+	}
+	status = expiryStatus{
+		at:        at,
+		remaining: at.Sub(now),
+	}
+	status.expired = status.remaining <= 0
+	if news.WarnBefore != nil {
+		if warnBefore, err := time.ParseDuration(*news.WarnBefore); err == nil {
+			status.warn = status.remaining <= warnBefore
+		}
+	}
+	return status, true
+}
@@ -0,0 +1,310 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+const (
+	// The length of a generated value when none is given.
+	defaultGeneratorLength = 32
+	// The characters a random value is drawn from when no charset is given.
+	defaultGeneratorCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+)
+
+// GeneratorKind names the way a Generator produces a value.
+type GeneratorKind string
+
+const (
+	GeneratorRandom  GeneratorKind = "random"
+	GeneratorDerive  GeneratorKind = "derive"
+	GeneratorPetname GeneratorKind = "petname"
+)
+
+func (GeneratorKind) Values() []infer.EnumValue[GeneratorKind] {
+	return []infer.EnumValue[GeneratorKind]{
+		{Name: "Random", Value: GeneratorRandom,
+			Description: "Draw `length` characters uniformly at random from `charset`."},
+		{Name: "Derive", Value: GeneratorDerive,
+			Description: "Compute `length` characters from `charset` with an HMAC-SHA256 of the " +
+				"triggers, keyed by the provider's `deriveSeed`. The same seed and triggers always " +
+				"derive the same value."},
+		{Name: "Petname", Value: GeneratorPetname,
+			Description: "Join `words` words from an embedded wordlist with `separator`: adjectives " +
+				"followed by a noun, such as `brave-otter`."},
+	}
+}
+
+// A Generator produces a fresh value each time the value it backs is rotated.
+type Generator struct {
+	Kind    GeneratorKind `pulumi:"kind"`
+	Length  *int          `pulumi:"length,optional"`
+	Charset *string       `pulumi:"charset,optional"`
+	// Words, Separator and MaxLength shape a petname.
+	Words     *int    `pulumi:"words,optional"`
+	Separator *string `pulumi:"separator,optional"`
+	MaxLength *int    `pulumi:"maxLength,optional"`
+	// Seed makes a random or petname generator reproducible: the same seed and triggers
+	// always generate the same value.
+	Seed *string `pulumi:"seed,optional"`
+}
+
+func (g *Generator) Annotate(a infer.Annotator) {
+	a.Describe(&g.Kind, "How values are generated.")
+	a.Describe(&g.Length, "How many characters a generated value has. Defaults to 32.")
+	a.Describe(&g.Charset, "The characters a generated value is made of. Defaults to letters and digits.")
+	a.Describe(&g.Words, "How many words a petname has. Defaults to 2.")
+	a.Describe(&g.Separator, "What the words of a petname are joined with. Defaults to `-`.")
+	a.Describe(&g.MaxLength, "The most characters a petname may have, separators included.")
+	a.Describe(&g.Seed, "Seeds a `random` or `petname` generator so that the same seed and triggers "+
+		"always generate the same value, such as in tests.")
+}
+
+const (
+	// The number of words in a petname when none is given.
+	defaultPetnameWords = 2
+	// What the words of a petname are joined with when no separator is given.
+	defaultPetnameSeparator = "-"
+)
+
+// A generatorInput is what a generator draws on besides its own settings. Only the
+// derive generator and seeded generators use it.
+type generatorInput struct {
+	// seed keys the derivation. It is the provider's deriveSeed.
+	seed *string
+	// label tells apart values derived from the same triggers, such as the fields of a pair.
+	label    string
+	triggers map[string]any
+}
+
+// generate produces a new value.
+func (g Generator) generate(in generatorInput) (string, error) {
+	if err := g.validate(); err != nil {
+		return "", err
+	}
+	var src entropy = cryptoEntropy{}
+	if g.Seed != nil {
+		src = newKeystream(*g.Seed, in.label, in.triggers)
+	}
+	switch g.Kind {
+	case GeneratorRandom:
+		return drawString(src, g.length(), g.charset())
+	case GeneratorDerive:
+		if in.seed == nil || *in.seed == "" {
+			return "", errDeriveSeed
+		}
+		return drawString(newKeystream(*in.seed, in.label, in.triggers), g.length(), g.charset())
+	case GeneratorPetname:
+		return petname(src, g.words(), g.separator(), g.MaxLength)
+	default:
+		return "", fmt.Errorf("unknown generator kind %q", g.Kind)
+	}
+}
+
+// validate reports the first problem with the settings of g.
+func (g Generator) validate() error {
+	switch g.Kind {
+	case GeneratorRandom, GeneratorDerive:
+		if g.Words != nil || g.Separator != nil || g.MaxLength != nil {
+			return errors.New("words, separator and maxLength only apply to a petname generator")
+		}
+		if g.Kind == GeneratorDerive && g.Seed != nil {
+			return errors.New("a derive generator is keyed by the provider's deriveSeed, not by seed")
+		}
+		if g.length() <= 0 {
+			return errors.New("length must be positive")
+		}
+		if len([]rune(g.charset())) == 0 {
+			return errors.New("charset must not be empty")
+		}
+		if (g.Kind == GeneratorDerive || g.Seed != nil) && len([]rune(g.charset())) > 1<<16 {
+			return errors.New("charset must not have more than 65536 characters")
+		}
+		return nil
+	case GeneratorPetname:
+		if g.Length != nil || g.Charset != nil {
+			return errors.New("length and charset do not apply to a petname generator")
+		}
+		if g.words() <= 0 {
+			return errors.New("words must be positive")
+		}
+		if budget := petnameWordBudget(g.words(), g.separator(), g.MaxLength); budget >= 0 &&
+			(len(petnameWords(petnameNouns, budget)) == 0 ||
+				g.words() > 1 && len(petnameWords(petnameAdjectives, budget)) == 0) {
+			return fmt.Errorf("maxLength %d is too short for %d words", *g.MaxLength, g.words())
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown generator kind %q", g.Kind)
+	}
+}
+
+func (g Generator) length() int {
+	if g.Length == nil {
+		return defaultGeneratorLength
+	}
+	return *g.Length
+}
+
+func (g Generator) charset() string {
+	if g.Charset == nil {
+		return defaultGeneratorCharset
+	}
+	return *g.Charset
+}
+
+func (g Generator) words() int {
+	if g.Words == nil {
+		return defaultPetnameWords
+	}
+	return *g.Words
+}
+
+func (g Generator) separator() string {
+	if g.Separator == nil {
+		return defaultPetnameSeparator
+	}
+	return *g.Separator
+}
+
+var errDeriveSeed = errors.New("the derive generator requires the provider's deriveSeed config")
+
+// checkSeed reports whether g can run with the given seed.
+func (g Generator) checkSeed(seed *string) error {
+	if g.Kind == GeneratorDerive && (seed == nil || *seed == "") {
+		return errDeriveSeed
+	}
+	return nil
+}
+
+// generateString generates the string to pin for args, labelling a derived string with
+// the resource name. Like a sourced string, it is held to the constraints of args.
+func generateString(ctx p.Context, name string, args StatefulStringArgs) (string, error) {
+	value, err := args.Generator.generate(generatorInput{
+		seed:     getConfig(ctx).DeriveSeed,
+		label:    name,
+		triggers: args.Triggers,
+	})
+	if err != nil {
+		return "", fmt.Errorf("generator: %w", err)
+	}
+	if err := checkCaptured(args, value); err != nil {
+		return "", fmt.Errorf("generated value is invalid: %w", err)
+	}
+	return value, nil
+}
+
+// checkGenerator validates the generator input of args. hasString reports whether the
+// raw inputs carry a literal string, which cannot be combined with a generator.
+func checkGenerator(ctx p.Context, args StatefulStringArgs, hasString bool) []p.CheckFailure {
+	if args.Generator == nil {
+		return nil
+	}
+	if hasString {
+		return []p.CheckFailure{{Property: "generator", Reason: "string and generator are mutually exclusive"}}
+	}
+	err := args.Generator.validate()
+	if err == nil {
+		err = args.Generator.checkSeed(getConfig(ctx).DeriveSeed)
+	}
+	if err != nil {
+		return []p.CheckFailure{{Property: "generator", Reason: err.Error()}}
+	}
+	return nil
+}
+
+// An entropy source draws the uniform choices a generator makes.
+type entropy interface {
+	// intn returns a uniform integer in [0, n).
+	intn(n int) (int, error)
+}
+
+// cryptoEntropy draws from crypto/rand.
+type cryptoEntropy struct{}
+
+func (cryptoEntropy) intn(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(v.Int64()), nil
+}
+
+// keystream is a deterministic entropy source: the HMAC-SHA256, keyed by a seed, of a
+// block counter followed by a label and the canonical encoding of the triggers.
+// Triggers are encoded the same way Diff compares them, so values generate alike
+// exactly when they diff alike.
+type keystream struct {
+	key     []byte
+	message []byte
+	counter uint32
+	buf     []byte
+}
+
+func newKeystream(seed, label string, triggers map[string]any) *keystream {
+	if triggers == nil {
+		triggers = map[string]any{}
+	}
+	return &keystream{
+		key:     []byte(seed),
+		message: append([]byte(label+"\x00"), canonicalJSON(triggers)...),
+	}
+}
+
+// intn draws two bytes at a time, rejecting draws past the largest multiple of n so
+// that every result is equally likely. n must be at most 65536.
+func (k *keystream) intn(n int) (int, error) {
+	limit := 1<<16 - (1<<16)%n
+	for {
+		if len(k.buf) < 2 {
+			mac := hmac.New(sha256.New, k.key)
+			var block [4]byte
+			binary.BigEndian.PutUint32(block[:], k.counter)
+			mac.Write(block[:])
+			mac.Write(k.message)
+			k.buf = mac.Sum(nil)
+			k.counter++
+		}
+		v := int(binary.BigEndian.Uint16(k.buf))
+		k.buf = k.buf[2:]
+		if v < limit {
+			return v % n, nil
+		}
+	}
+}
+
+// drawString draws length characters uniformly from charset.
+func drawString(src entropy, length int, charset string) (string, error) {
+	chars := []rune(charset)
+	out := make([]rune, length)
+	for i := range out {
+		n, err := src.intn(len(chars))
+		if err != nil {
+			return "", err
+		}
+		out[i] = chars[n]
+	}
+	return string(out), nil
+}
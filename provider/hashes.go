@@ -0,0 +1,266 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// The argon2id parameters used when none are given, as recommended by x/crypto/argon2.
+	defaultArgon2Time    = 1
+	defaultArgon2Memory  = 64 * 1024
+	defaultArgon2Threads = 4
+	// The largest argon2id parameters allowed, so that a typo cannot stall an update or
+	// exhaust the memory of the machine running the provider: 10 passes over 4 GiB.
+	maxArgon2Time   = 10
+	maxArgon2Memory = 4 * 1024 * 1024
+	// The scrypt parameters used when none are given: N = 2^15, r = 8, p = 1.
+	defaultScryptLogN = 15
+	defaultScryptR    = 8
+	defaultScryptP    = 1
+	// The largest costs allowed for bcrypt and scrypt, for the same reason: a bcrypt cost
+	// of 16 already takes seconds, and scrypt is held to 1 GiB of the 128·r·N bytes it uses.
+	maxBcryptCost   = 16
+	maxScryptMemory = 1 << 30
+	// The salt and key sizes of argon2id and scrypt hashes, in bytes.
+	hashSaltSize = 16
+	hashKeySize  = 32
+)
+
+// HashOptions selects the hashes computed from the pinned string. Each hash is salted,
+// so it is only computed when the pinned string or its own options change; otherwise
+// every update would produce a new hash and a spurious diff downstream.
+type HashOptions struct {
+	Bcrypt *BcryptOptions `pulumi:"bcrypt,optional"`
+	Argon2 *Argon2Options `pulumi:"argon2,optional"`
+	Scrypt *ScryptOptions `pulumi:"scrypt,optional"`
+}
+
+type BcryptOptions struct {
+	Cost *int `pulumi:"cost,optional"`
+}
+
+type Argon2Options struct {
+	Time    *int `pulumi:"time,optional"`
+	Memory  *int `pulumi:"memory,optional"`
+	Threads *int `pulumi:"threads,optional"`
+}
+
+type ScryptOptions struct {
+	LogN *int `pulumi:"logN,optional"`
+	R    *int `pulumi:"r,optional"`
+	P    *int `pulumi:"p,optional"`
+}
+
+func (h *HashOptions) Annotate(a infer.Annotator) {
+	a.Describe(&h.Bcrypt, "Compute `bcryptHash`.")
+	a.Describe(&h.Argon2, "Compute `argon2Hash`, an argon2id hash.")
+	a.Describe(&h.Scrypt, "Compute `scryptHash`.")
+}
+
+func (o *BcryptOptions) Annotate(a infer.Annotator) {
+	a.Describe(&o.Cost, "The bcrypt cost, from 4 to 16. Defaults to 10.")
+}
+
+func (o *Argon2Options) Annotate(a infer.Annotator) {
+	a.Describe(&o.Time, "The number of passes over the memory, from 1 to 10. Defaults to 1.")
+	a.Describe(&o.Memory, "The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.")
+	a.Describe(&o.Threads, "The degree of parallelism, from 1 to 255. Defaults to 4.")
+}
+
+func (o *ScryptOptions) Annotate(a infer.Annotator) {
+	a.Describe(&o.LogN, "The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.")
+	a.Describe(&o.R, "The block size. Defaults to 8.")
+	a.Describe(&o.P, "The parallelization. Defaults to 1.")
+}
+
+func (o BcryptOptions) cost() int {
+	if o.Cost == nil {
+		return bcrypt.DefaultCost
+	}
+	return *o.Cost
+}
+
+func (o Argon2Options) params() (time, memory, threads int) {
+	time, memory, threads = defaultArgon2Time, defaultArgon2Memory, defaultArgon2Threads
+	if o.Time != nil {
+		time = *o.Time
+	}
+	if o.Memory != nil {
+		memory = *o.Memory
+	}
+	if o.Threads != nil {
+		threads = *o.Threads
+	}
+	return time, memory, threads
+}
+
+func (o ScryptOptions) params() (logN, r, p int) {
+	logN, r, p = defaultScryptLogN, defaultScryptR, defaultScryptP
+	if o.LogN != nil {
+		logN = *o.LogN
+	}
+	if o.R != nil {
+		r = *o.R
+	}
+	if o.P != nil {
+		p = *o.P
+	}
+	return logN, r, p
+}
+
+// checkHashes validates the hash options of args.
+func checkHashes(args StatefulStringArgs) []p.CheckFailure {
+	if args.Hashes == nil {
+		return nil
+	}
+	var failures []p.CheckFailure
+	fail := func(property, format string, a ...any) {
+		failures = append(failures, p.CheckFailure{
+			Property: "hashes." + property,
+			Reason:   fmt.Sprintf(format, a...),
+		})
+	}
+	if h := args.Hashes.Bcrypt; h != nil {
+		if cost := h.cost(); cost < bcrypt.MinCost || cost > maxBcryptCost {
+			fail("bcrypt.cost", "cost must be between %d and %d, got %d", bcrypt.MinCost, maxBcryptCost, cost)
+		}
+		if len(args.String) > 72 && !args.external() {
+			fail("bcrypt", "bcrypt only hashes strings of up to 72 bytes")
+		}
+	}
+	if h := args.Hashes.Argon2; h != nil {
+		time, memory, threads := h.params()
+		if time < 1 || time > maxArgon2Time {
+			fail("argon2.time", "time must be between 1 and %d, got %d", maxArgon2Time, time)
+		}
+		if threads < 1 || threads > 255 {
+			fail("argon2.threads", "threads must be between 1 and 255, got %d", threads)
+		}
+		if memory < 8*threads {
+			fail("argon2.memory", "memory must be at least 8 KiB per thread, got %d", memory)
+		} else if memory > maxArgon2Memory {
+			fail("argon2.memory", "memory must be at most %d KiB, got %d", maxArgon2Memory, memory)
+		}
+	}
+	if h := args.Hashes.Scrypt; h != nil {
+		logN, r, p := h.params()
+		if logN < 1 || logN > 30 {
+			fail("scrypt.logN", "logN must be between 1 and 30, got %d", logN)
+		}
+		if r < 1 || p < 1 || r*p >= 1<<30 {
+			fail("scrypt", "r and p must be positive with r*p below 2^30, got r=%d p=%d", r, p)
+		} else if logN >= 1 && logN <= 30 && r > maxScryptMemory/(128<<logN) {
+			fail("scrypt", "128*r*N must be at most %d bytes, got r=%d N=2^%d", maxScryptMemory, r, logN)
+		}
+	}
+	return failures
+}
+
+// updateHashes sets the hashes of output, reusing those of olds unless the pinned string
+// or the options of a hash changed. olds is the zero state on create.
+func updateHashes(output *StatefulStringState, olds StatefulStringState) error {
+	var opts, oldOpts HashOptions
+	if output.Hashes != nil {
+		opts = *output.Hashes
+	}
+	if olds.Hashes != nil {
+		oldOpts = *olds.Hashes
+	}
+	value := []byte(output.String)
+	rehash := func(enabled bool, sameOpts bool, old *string, hash func() (string, error)) (*string, error) {
+		switch {
+		case !enabled:
+			return nil, nil
+		case old != nil && sameOpts && output.String == olds.String:
+			return old, nil
+		}
+		h, err := hash()
+		if err != nil {
+			return nil, err
+		}
+		return &h, nil
+	}
+
+	var err error
+	output.BcryptHash, err = rehash(opts.Bcrypt != nil, reflect.DeepEqual(opts.Bcrypt, oldOpts.Bcrypt),
+		olds.BcryptHash, func() (string, error) {
+			h, err := bcrypt.GenerateFromPassword(value, opts.Bcrypt.cost())
+			if err != nil {
+				return "", fmt.Errorf("bcrypt: %w", err)
+			}
+			return string(h), nil
+		})
+	if err != nil {
+		return err
+	}
+	output.Argon2Hash, err = rehash(opts.Argon2 != nil, reflect.DeepEqual(opts.Argon2, oldOpts.Argon2),
+		olds.Argon2Hash, func() (string, error) {
+			return argon2Hash(value, *opts.Argon2)
+		})
+	if err != nil {
+		return err
+	}
+	output.ScryptHash, err = rehash(opts.Scrypt != nil, reflect.DeepEqual(opts.Scrypt, oldOpts.Scrypt),
+		olds.ScryptHash, func() (string, error) {
+			return scryptHash(value, *opts.Scrypt)
+		})
+	return err
+}
+
+// argon2Hash returns the argon2id hash of value in the PHC string format.
+func argon2Hash(value []byte, opts Argon2Options) (string, error) {
+	salt, err := hashSalt()
+	if err != nil {
+		return "", err
+	}
+	time, memory, threads := opts.params()
+	key := argon2.IDKey(value, salt, uint32(time), uint32(memory), uint8(threads), hashKeySize)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, memory, time, threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// scryptHash returns the scrypt hash of value in the PHC-style format passlib reads.
+func scryptHash(value []byte, opts ScryptOptions) (string, error) {
+	salt, err := hashSalt()
+	if err != nil {
+		return "", err
+	}
+	logN, r, p := opts.params()
+	key, err := scrypt.Key(value, salt, 1<<logN, r, p, hashKeySize)
+	if err != nil {
+		return "", fmt.Errorf("scrypt: %w", err)
+	}
+	return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s", logN, r, p,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func hashSalt() ([]byte, error) {
+	salt := make([]byte, hashSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
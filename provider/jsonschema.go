@@ -0,0 +1,129 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// The URL the inline schema is registered under. It is only used to resolve relative
+// references inside the schema.
+const jsonSchemaURL = "statefulstring://jsonSchema.json"
+
+// checkJSONSchema validates the string that is about to be pinned against
+// args.JSONSchema. Every failing location in the document becomes its own failure,
+// with the JSON path of the offending value leading the reason.
+func checkJSONSchema(args StatefulStringArgs) []p.CheckFailure {
+	if args.JSONSchema == nil {
+		return nil
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(jsonSchemaURL, strings.NewReader(*args.JSONSchema)); err != nil {
+		return []p.CheckFailure{{Property: "jsonSchema", Reason: fmt.Sprintf("invalid JSON schema: %s", err)}}
+	}
+	schema, err := compiler.Compile(jsonSchemaURL)
+	if err != nil {
+		return []p.CheckFailure{{Property: "jsonSchema", Reason: fmt.Sprintf("invalid JSON schema: %s", err)}}
+	}
+
+	doc, err := parseJSON(args.String)
+	if err != nil {
+		return []p.CheckFailure{{Property: "string", Reason: err.Error()}}
+	}
+
+	var verr *jsonschema.ValidationError
+	if err := schema.Validate(doc); errors.As(err, &verr) {
+		// Causes are collected from maps, so order them by location for stable output
+		leaves := validationLeaves(verr)
+		sort.SliceStable(leaves, func(i, j int) bool {
+			return leaves[i].InstanceLocation < leaves[j].InstanceLocation
+		})
+		failures := []p.CheckFailure{}
+		for _, leaf := range leaves {
+			failures = append(failures, p.CheckFailure{
+				Property: "string",
+				Reason:   fmt.Sprintf("%s: %s", jsonPath(leaf.InstanceLocation), leaf.Message),
+			})
+		}
+		return failures
+	} else if err != nil {
+		return []p.CheckFailure{{Property: "string", Reason: err.Error()}}
+	}
+	return nil
+}
+
+// parseJSON decodes a pinned JSON document. Only objects are accepted, so the result
+// can be exposed as the `parsed` object output.
+func parseJSON(s string) (map[string]any, error) {
+	var doc any
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		return nil, fmt.Errorf("value is not valid JSON: %w", err)
+	}
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("value must be a JSON object")
+	}
+	return obj, nil
+}
+
+// validationLeaves flattens a validation error down to the errors that have no further
+// causes, which are the ones that name a concrete failing value.
+func validationLeaves(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	leaves := []*jsonschema.ValidationError{}
+	for _, cause := range err.Causes {
+		leaves = append(leaves, validationLeaves(cause)...)
+	}
+	return leaves
+}
+
+// jsonPath converts a JSON pointer such as `/spec/replicas` into `$.spec.replicas`.
+func jsonPath(pointer string) string {
+	path := "$"
+	if pointer == "" {
+		return path
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+		if isIndex(token) {
+			path += "[" + token + "]"
+		} else {
+			path += "." + token
+		}
+	}
+	return path
+}
+
+func isIndex(token string) bool {
+	if token == "" {
+		return false
+	}
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,288 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"slices"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"golang.org/x/crypto/ssh"
+)
+
+// The size of an RSA key when none is given.
+const defaultRSABits = 4096
+
+// The RSA key sizes allowed: large enough to be safe, and small enough that generating a
+// key does not stall an update.
+var rsaKeySizes = []int{2048, 3072, 4096}
+
+// KeyAlgorithm names the kind of key a StatefulKeyPair generates.
+type KeyAlgorithm string
+
+const (
+	KeyEd25519 KeyAlgorithm = "ed25519"
+	KeyRSA     KeyAlgorithm = "rsa"
+	KeyECDSA   KeyAlgorithm = "ecdsa"
+)
+
+func (KeyAlgorithm) Values() []infer.EnumValue[KeyAlgorithm] {
+	return []infer.EnumValue[KeyAlgorithm]{
+		{Name: "Ed25519", Value: KeyEd25519, Description: "An Ed25519 key."},
+		{Name: "RSA", Value: KeyRSA, Description: "An RSA key of `rsaBits` bits."},
+		{Name: "ECDSA", Value: KeyECDSA, Description: "An ECDSA key on `ecdsaCurve`."},
+	}
+}
+
+// ECDSACurve names the curve of an ECDSA key.
+type ECDSACurve string
+
+const (
+	CurveP256 ECDSACurve = "P256"
+	CurveP384 ECDSACurve = "P384"
+	CurveP521 ECDSACurve = "P521"
+)
+
+func (ECDSACurve) Values() []infer.EnumValue[ECDSACurve] {
+	return []infer.EnumValue[ECDSACurve]{
+		{Name: "P256", Value: CurveP256, Description: "NIST P-256."},
+		{Name: "P384", Value: CurveP384, Description: "NIST P-384."},
+		{Name: "P521", Value: CurveP521, Description: "NIST P-521."},
+	}
+}
+
+// StatefulKeyPair pins a generated key pair, such as an SSH deploy key or a JWT signing
+// key, until one of its triggers changes.
+type StatefulKeyPair struct{}
+
+type StatefulKeyPairArgs struct {
+	Algorithm  KeyAlgorithm   `pulumi:"algorithm"`
+	RSABits    *int           `pulumi:"rsaBits,optional"`
+	ECDSACurve *ECDSACurve    `pulumi:"ecdsaCurve,optional"`
+	Triggers   map[string]any `pulumi:"triggers,optional"`
+}
+
+type StatefulKeyPairState struct {
+	// The key settings are those of the pinned key, which may lag behind the inputs until
+	// the next rotation.
+	StatefulKeyPairArgs
+	PrivateKeyPem     string `pulumi:"privateKeyPem" provider:"secret"`
+	PrivateKeyOpenssh string `pulumi:"privateKeyOpenssh" provider:"secret"`
+	PublicKeyPem      string `pulumi:"publicKeyPem"`
+	PublicKeyOpenssh  string `pulumi:"publicKeyOpenssh"`
+	// FingerprintSha256 is the OpenSSH SHA256 fingerprint of the public key.
+	FingerprintSha256 string `pulumi:"fingerprintSha256"`
+}
+
+func (kp *StatefulKeyPair) Annotate(a infer.Annotator) {
+	a.Describe(&kp, "A generated key pair that stays pinned until one of its triggers changes. "+
+		"Changes to the key settings are only applied by the next rotation.")
+}
+
+func (a *StatefulKeyPairArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Algorithm, "The kind of key to generate.")
+	an.Describe(&a.RSABits, "The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.")
+	an.Describe(&a.ECDSACurve, "The curve of an ECDSA key. Defaults to `P256`.")
+	an.Describe(&a.Triggers, "Arbitrary values that regenerate the key pair whenever any of them is "+
+		"added, removed or changed.")
+}
+
+func (s *StatefulKeyPairState) Annotate(a infer.Annotator) {
+	a.Describe(&s.PrivateKeyPem, "The private key in PKCS #8 PEM format.")
+	a.Describe(&s.PrivateKeyOpenssh, "The private key in OpenSSH format.")
+	a.Describe(&s.PublicKeyPem, "The public key in PKIX PEM format.")
+	a.Describe(&s.PublicKeyOpenssh, "The public key in OpenSSH authorized_keys format.")
+	a.Describe(&s.FingerprintSha256, "The OpenSSH SHA256 fingerprint of the public key.")
+}
+
+// WireDependencies keeps the default wiring, where every output depends on every input,
+// and additionally always treats the private key as secret.
+func (kp StatefulKeyPair) WireDependencies(f infer.FieldSelector, args *StatefulKeyPairArgs, state *StatefulKeyPairState) {
+	f.OutputField(state).DependsOn(f.InputField(args))
+	f.OutputField(&state.PrivateKeyPem).AlwaysSecret()
+	f.OutputField(&state.PrivateKeyOpenssh).AlwaysSecret()
+}
+
+func (kp StatefulKeyPair) Check(ctx p.Context, name string, olds resource.PropertyMap, news resource.PropertyMap) (StatefulKeyPairArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[StatefulKeyPairArgs](news)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	return args, append(failures, args.check()...), nil
+}
+
+// check validates the key settings of a.
+func (a StatefulKeyPairArgs) check() []p.CheckFailure {
+	var failures []p.CheckFailure
+	if a.RSABits != nil && a.Algorithm != KeyRSA {
+		failures = append(failures, p.CheckFailure{Property: "rsaBits", Reason: "rsaBits only applies to rsa keys"})
+	} else if !slices.Contains(rsaKeySizes, a.rsaBits()) {
+		failures = append(failures, p.CheckFailure{
+			Property: "rsaBits",
+			Reason:   fmt.Sprintf("rsaBits must be one of %v, got %d", rsaKeySizes, a.rsaBits()),
+		})
+	}
+	if a.ECDSACurve != nil && a.Algorithm != KeyECDSA {
+		failures = append(failures, p.CheckFailure{Property: "ecdsaCurve", Reason: "ecdsaCurve only applies to ecdsa keys"})
+	}
+	return failures
+}
+
+func (a StatefulKeyPairArgs) rsaBits() int {
+	if a.RSABits == nil {
+		return defaultRSABits
+	}
+	return *a.RSABits
+}
+
+func (a StatefulKeyPairArgs) curveName() ECDSACurve {
+	if a.ECDSACurve == nil {
+		return CurveP256
+	}
+	return *a.ECDSACurve
+}
+
+func (a StatefulKeyPairArgs) curve() (elliptic.Curve, error) {
+	switch curve := a.curveName(); curve {
+	case CurveP256:
+		return elliptic.P256(), nil
+	case CurveP384:
+		return elliptic.P384(), nil
+	case CurveP521:
+		return elliptic.P521(), nil
+	default:
+		return nil, fmt.Errorf("unknown ecdsa curve %q", curve)
+	}
+}
+
+func (kp StatefulKeyPair) Create(ctx p.Context, name string, input StatefulKeyPairArgs, preview bool) (id string, output StatefulKeyPairState, err error) {
+	output = StatefulKeyPairState{StatefulKeyPairArgs: input}
+	// Previews never generate, so the keys are unknown until the update runs
+	if !preview {
+		output, err = generateKeyPair(input)
+	}
+	return name, output, err
+}
+
+func (kp StatefulKeyPair) Diff(ctx p.Context, name string, olds StatefulKeyPairState, news StatefulKeyPairArgs) (p.DiffResponse, error) {
+	changeMap := map[string]p.PropertyDiff{}
+
+	// A rotation replaces the whole key pair, so it is reported as a single change
+	if diffTriggers(olds.Triggers, news.Triggers, changeMap) {
+		changeMap["privateKeyPem"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
+		}
+	} else if !olds.sameKeySettings(news) {
+		ctx.Logf(diag.Warning, "%s: the key settings differ from the pinned key, which is kept until "+
+			"the next rotation", name)
+	}
+
+	return p.DiffResponse{
+		HasChanges:   len(changeMap) > 0,
+		DetailedDiff: changeMap,
+	}, nil
+}
+
+func (kp StatefulKeyPair) Update(ctx p.Context, name string, olds StatefulKeyPairState, news StatefulKeyPairArgs, preview bool) (StatefulKeyPairState, error) {
+	if !diffTriggers(olds.Triggers, news.Triggers, map[string]p.PropertyDiff{}) {
+		// Keep the pinned key and the settings it was generated with
+		output := olds
+		output.Triggers = news.Triggers
+		return output, nil
+	}
+	if preview {
+		return StatefulKeyPairState{StatefulKeyPairArgs: news}, nil
+	}
+	return generateKeyPair(news)
+}
+
+// sameKeySettings reports whether news would generate the same kind of key as olds.
+func (s StatefulKeyPairState) sameKeySettings(news StatefulKeyPairArgs) bool {
+	return s.Algorithm == news.Algorithm && s.rsaBits() == news.rsaBits() &&
+		s.curveName() == news.curveName()
+}
+
+// generateKeyPair generates a new key pair as args describe.
+func generateKeyPair(args StatefulKeyPairArgs) (StatefulKeyPairState, error) {
+	key, err := args.generateKey()
+	if err != nil {
+		return StatefulKeyPairState{}, err
+	}
+	private, err := privateKeyPem(key)
+	if err != nil {
+		return StatefulKeyPairState{}, err
+	}
+	public, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return StatefulKeyPairState{}, err
+	}
+	sshPrivate, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		return StatefulKeyPairState{}, err
+	}
+	sshPublic, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		return StatefulKeyPairState{}, err
+	}
+	return StatefulKeyPairState{
+		StatefulKeyPairArgs: args,
+		PrivateKeyPem:       private,
+		PrivateKeyOpenssh:   string(pem.EncodeToMemory(sshPrivate)),
+		PublicKeyPem:        string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: public})),
+		PublicKeyOpenssh:    strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshPublic))),
+		FingerprintSha256:   ssh.FingerprintSHA256(sshPublic),
+	}, nil
+}
+
+// generateKey generates a new private key as a describes.
+func (a StatefulKeyPairArgs) generateKey() (crypto.Signer, error) {
+	switch a.Algorithm {
+	case KeyEd25519:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		return key, err
+	case KeyRSA:
+		return rsa.GenerateKey(rand.Reader, a.rsaBits())
+	case KeyECDSA:
+		curve, err := a.curve()
+		if err != nil {
+			return nil, err
+		}
+		return ecdsa.GenerateKey(curve, rand.Reader)
+	default:
+		return nil, fmt.Errorf("unknown key algorithm %q", a.Algorithm)
+	}
+}
+
+// privateKeyPem encodes key in PKCS #8 PEM format.
+func privateKeyPem(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), nil
+}
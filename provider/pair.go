@@ -0,0 +1,222 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// The fewest fields a StatefulStringPair can pin.
+const minPairFields = 2
+
+// StatefulStringPair pins two or more named strings, such as a username and a password,
+// that share one set of triggers and always rotate together.
+type StatefulStringPair struct{}
+
+// A PairField is one named value of a StatefulStringPair. Exactly one of Value and
+// Generator is set.
+type PairField struct {
+	Value     *string    `pulumi:"value,optional"`
+	Generator *Generator `pulumi:"generator,optional"`
+}
+
+type StatefulStringPairArgs struct {
+	Fields   map[string]PairField `pulumi:"fields"`
+	Triggers map[string]any       `pulumi:"triggers,optional"`
+}
+
+type StatefulStringPairState struct {
+	StatefulStringPairArgs
+	// Values holds the pinned value of every field, by field name.
+	Values map[string]string `pulumi:"values" provider:"secret"`
+}
+
+func (sp *StatefulStringPair) Annotate(a infer.Annotator) {
+	a.Describe(&sp, "Two or more named strings, such as a username and a password, that share one set "+
+		"of triggers and always rotate together.")
+}
+
+func (f *PairField) Annotate(a infer.Annotator) {
+	a.Describe(&f.Value, "The value to pin. Exactly one of `value` and `generator` is set.")
+	a.Describe(&f.Generator, "How to generate the value to pin. A new value is only generated when the "+
+		"pair rotates.")
+}
+
+func (a *StatefulStringPairArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Fields, "The fields to pin, by name. At least two are required.")
+	an.Describe(&a.Triggers, "Arbitrary values that rotate every field whenever any of them is added, "+
+		"removed or changed.")
+}
+
+func (s *StatefulStringPairState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Values, "The pinned value of every field, by field name.")
+}
+
+// WireDependencies keeps the default wiring, where every output depends on every input,
+// and additionally always treats the pinned values as secret.
+func (sp StatefulStringPair) WireDependencies(f infer.FieldSelector, args *StatefulStringPairArgs, state *StatefulStringPairState) {
+	f.OutputField(state).DependsOn(f.InputField(args))
+	f.OutputField(&state.Values).AlwaysSecret()
+}
+
+func (sp StatefulStringPair) Check(ctx p.Context, name string, olds resource.PropertyMap, news resource.PropertyMap) (StatefulStringPairArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[StatefulStringPairArgs](news)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	if news["fields"].ContainsUnknowns() {
+		return args, failures, nil
+	}
+
+	if len(args.Fields) < minPairFields {
+		failures = append(failures, p.CheckFailure{
+			Property: "fields",
+			Reason:   fmt.Sprintf("a pair needs at least %d fields, got %d", minPairFields, len(args.Fields)),
+		})
+	}
+	for _, field := range sortedFieldNames(args.Fields) {
+		f := args.Fields[field]
+		property := "fields." + field
+		switch {
+		case (f.Value == nil) == (f.Generator == nil):
+			failures = append(failures, p.CheckFailure{
+				Property: property,
+				Reason:   "exactly one of value and generator must be set",
+			})
+		case f.Generator != nil:
+			err := f.Generator.validate()
+			if err == nil {
+				err = f.Generator.checkSeed(getConfig(ctx).DeriveSeed)
+			}
+			if err != nil {
+				failures = append(failures, p.CheckFailure{
+					Property: property + ".generator",
+					Reason:   err.Error(),
+				})
+			}
+		}
+	}
+	return args, failures, nil
+}
+
+func (sp StatefulStringPair) Create(ctx p.Context, name string, input StatefulStringPairArgs, preview bool) (id string, output StatefulStringPairState, err error) {
+	values, err := pairValues(ctx, name, input, nil, true, preview)
+	if err != nil {
+		return "", StatefulStringPairState{}, err
+	}
+	return name, StatefulStringPairState{StatefulStringPairArgs: input, Values: values}, nil
+}
+
+func (sp StatefulStringPair) Diff(ctx p.Context, name string, olds StatefulStringPairState, news StatefulStringPairArgs) (p.DiffResponse, error) {
+	changeMap := map[string]p.PropertyDiff{}
+
+	// A rotation replaces every field at once, so it is reported as a single change
+	if diffTriggers(olds.Triggers, news.Triggers, changeMap) {
+		changeMap["values"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
+		}
+	}
+
+	// Fields can be added, removed or reconfigured without rotating the others
+	for field, f := range news.Fields {
+		old, exists := olds.Fields[field]
+		if !exists {
+			changeMap["fields."+field] = p.PropertyDiff{
+				Kind:      p.DiffKind("add"),
+				InputDiff: false,
+			}
+		} else if !reflect.DeepEqual(old, f) {
+			changeMap["fields."+field] = p.PropertyDiff{
+				Kind:      p.DiffKind("update"),
+				InputDiff: false,
+			}
+		}
+	}
+	for field := range olds.Fields {
+		if _, exists := news.Fields[field]; !exists {
+			changeMap["fields."+field] = p.PropertyDiff{
+				Kind:      p.DiffKind("delete"),
+				InputDiff: false,
+			}
+		}
+	}
+
+	return p.DiffResponse{
+		HasChanges:   len(changeMap) > 0,
+		DetailedDiff: changeMap,
+	}, nil
+}
+
+func (sp StatefulStringPair) Update(ctx p.Context, name string, olds StatefulStringPairState, news StatefulStringPairArgs, preview bool) (StatefulStringPairState, error) {
+	rotate := diffTriggers(olds.Triggers, news.Triggers, map[string]p.PropertyDiff{})
+	values, err := pairValues(ctx, name, news, olds.Values, rotate, preview)
+	if err != nil {
+		return StatefulStringPairState{}, err
+	}
+	return StatefulStringPairState{StatefulStringPairArgs: news, Values: values}, nil
+}
+
+// pairValues computes the pinned value of every field of args. Fields already pinned in
+// olds keep their value unless rotate is set. Previews never run a generator, so a
+// generated field without an old value is left out until the update runs. Derived
+// fields are labelled with the resource and field name, so that no two fields derive
+// the same value.
+func pairValues(ctx p.Context, name string, args StatefulStringPairArgs, olds map[string]string,
+	rotate, preview bool) (map[string]string, error) {
+	seed := getConfig(ctx).DeriveSeed
+	values := make(map[string]string, len(args.Fields))
+	for field, f := range args.Fields {
+		old, pinned := olds[field]
+		switch {
+		case pinned && !rotate:
+			values[field] = old
+		case f.Value != nil:
+			values[field] = *f.Value
+		case f.Generator == nil:
+			return nil, fmt.Errorf("field %q has neither a value nor a generator", field)
+		case preview:
+			if pinned {
+				values[field] = old
+			}
+		default:
+			value, err := f.Generator.generate(generatorInput{
+				seed:     seed,
+				label:    name + "." + field,
+				triggers: args.Triggers,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", field, err)
+			}
+			values[field] = value
+		}
+	}
+	return values, nil
+}
+
+func sortedFieldNames(fields map[string]PairField) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,77 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	_ "embed"
+	"strings"
+	"unicode/utf8"
+)
+
+// The wordlists petnames are drawn from, one word per line.
+var (
+	//go:embed wordlist/adjectives.txt
+	adjectiveList string
+	//go:embed wordlist/nouns.txt
+	nounList string
+
+	petnameAdjectives = strings.Fields(adjectiveList)
+	petnameNouns      = strings.Fields(nounList)
+)
+
+// petname joins words words with separator: adjectives followed by a single noun. When
+// maxLength is set, every word is drawn from those short enough for the name to fit.
+func petname(src entropy, words int, separator string, maxLength *int) (string, error) {
+	budget := petnameWordBudget(words, separator, maxLength)
+	parts := make([]string, words)
+	for i := range parts {
+		list := petnameAdjectives
+		if i == words-1 {
+			list = petnameNouns
+		}
+		list = petnameWords(list, budget)
+		n, err := src.intn(len(list))
+		if err != nil {
+			return "", err
+		}
+		parts[i] = list[n]
+	}
+	return strings.Join(parts, separator), nil
+}
+
+// petnameWordBudget returns the most characters each word may have for a name of words
+// words to fit in maxLength, or -1 when there is no limit.
+func petnameWordBudget(words int, separator string, maxLength *int) int {
+	if maxLength == nil {
+		return -1
+	}
+	budget := (*maxLength - (words-1)*utf8.RuneCountInString(separator)) / words
+	return max(budget, 0)
+}
+
+// petnameWords returns the words of list with at most budget characters. A negative
+// budget keeps every word.
+func petnameWords(list []string, budget int) []string {
+	if budget < 0 {
+		return list
+	}
+	var fit []string
+	for _, w := range list {
+		if len(w) <= budget {
+			fit = append(fit, w)
+		}
+	}
+	return fit
+}
@@ -15,8 +15,16 @@
 package provider
 
 import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
 	p "github.com/pulumi/pulumi-go-provider"
 	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi-go-provider/middleware/schema"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
 	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
 )
 
@@ -26,16 +34,98 @@ var Version string
 const Name string = "statefulString"
 
 func Provider() p.Provider {
-	// We tell the provider what resources it needs to support.
-	// In this case, a single custom resource.
-	return infer.Provider(infer.Options{
+	// We tell the provider what resources and functions it needs to support,
+	// and how it is configured.
+	prov := infer.Provider(infer.Options{
+		Metadata: schema.Metadata{
+			LanguageMap: map[string]any{
+				"go": map[string]any{
+					"importBasePath": "github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString",
+				},
+			},
+		},
 		Resources: []infer.InferredResource{
 			infer.Resource[StatefulString, StatefulStringArgs, StatefulStringState](),
+			infer.Resource[SharedStatefulString, SharedStatefulStringArgs, SharedStatefulStringState](),
+			infer.Resource[StatefulStringPair, StatefulStringPairArgs, StatefulStringPairState](),
+			infer.Resource[StatefulKeyPair, StatefulKeyPairArgs, StatefulKeyPairState](),
+			infer.Resource[StatefulCertificate, StatefulCertificateArgs, StatefulCertificateState](),
+		},
+		Components: []infer.InferredComponent{
+			infer.Component[SecretBundle, SecretBundleArgs, *SecretBundleState](),
 		},
+		Functions: []infer.InferredFunction{
+			infer.Function[GetSharedStatefulString, GetSharedStatefulStringArgs, GetSharedStatefulStringResult](),
+			infer.Function[GetRotationGroupTrigger, GetRotationGroupTriggerArgs, GetRotationGroupTriggerResult](),
+		},
+		Config: infer.Config[Config](),
 		ModuleMap: map[tokens.ModuleName]tokens.ModuleName{
 			"provider": "index",
 		},
 	})
+
+	// infer re-encodes the inputs returned by a custom Check, which strips secret and
+	// unknown markers. Our checks only validate and never remap inputs, so hand the
+	// engine back the inputs exactly as they were given.
+	check := prov.Check
+	prov.Check = func(ctx p.Context, req p.CheckRequest) (p.CheckResponse, error) {
+		resp, err := check(ctx, req)
+		if err == nil {
+			resp.Inputs = req.News
+		}
+		return resp, err
+	}
+	// The typed Diff only sees its inputs without their secret markers, so note which
+	// trigger values were secret before they are explained
+	diff := prov.Diff
+	prov.Diff = func(ctx p.Context, req p.DiffRequest) (p.DiffResponse, error) {
+		return diff(withSecretTriggers(ctx, req.Olds, req.News), req)
+	}
+	// Tombstones are kept per project and stack, which only the URN tells
+	create, del := prov.Create, prov.Delete
+	prov.Create = func(ctx p.Context, req p.CreateRequest) (p.CreateResponse, error) {
+		resp, err := create(withURN(ctx, req.Urn), req)
+		resp.Properties = secretPinnedValues(req.Urn, resp.Properties)
+		return resp, err
+	}
+	prov.Delete = func(ctx p.Context, req p.DeleteRequest) error {
+		return del(withURN(ctx, req.Urn), req)
+	}
+	update, read := prov.Update, prov.Read
+	prov.Update = func(ctx p.Context, req p.UpdateRequest) (p.UpdateResponse, error) {
+		resp, err := update(ctx, req)
+		resp.Properties = secretPinnedValues(req.Urn, resp.Properties)
+		return resp, err
+	}
+	prov.Read = func(ctx p.Context, req p.ReadRequest) (p.ReadResponse, error) {
+		resp, err := read(ctx, req)
+		resp.Properties = secretPinnedValues(req.Urn, resp.Properties)
+		return resp, err
+	}
+	return prov
+}
+
+// pinnedValueOutputs are the outputs of a StatefulString that hold the pinned string or a
+// form of it.
+var pinnedValueOutputs = []resource.PropertyKey{
+	"string", "result", "parsed", "current", "desiredString", "previous", "pending", "currentSourceValue",
+}
+
+// secretPinnedValues marks the pinned values in the state of a StatefulString as secret
+// when its string is sourced or generated: such a string never passed through the program,
+// so the program had no chance to mark it secret. A literal string keeps whatever
+// secretness the program gave it. AlwaysSecret cannot depend on the inputs, so this runs
+// on the encoded state instead.
+func secretPinnedValues(urn resource.URN, props resource.PropertyMap) resource.PropertyMap {
+	if urn.Type().Name() != "StatefulString" || !props.HasValue("source") && !props.HasValue("generator") {
+		return props
+	}
+	for _, key := range pinnedValueOutputs {
+		if v, ok := props[key]; ok && !v.IsNull() && !v.IsSecret() {
+			props[key] = resource.MakeSecret(v)
+		}
+	}
+	return props
 }
 
 // Each resource has a controlling struct.
@@ -55,81 +145,331 @@ type StatefulStringArgs struct {
 	// Fields projected into Pulumi must be public and hava a `pulumi:"..."` tag.
 	// The pulumi tag doesn't need to match the field name, but it's generally a
 	// good idea.
-	String   string            `pulumi:"string"`
-	Triggers map[string]string `pulumi:"triggers"`
+	String   string         `pulumi:"string,optional"`
+	Triggers map[string]any `pulumi:"triggers,optional"`
+	// SensitiveTriggers names the triggers whose values are masked, down to their last
+	// four characters, when a diff explains what changed.
+	SensitiveTriggers []string `pulumi:"sensitiveTriggers,optional"`
+	// Source reads the string to pin from outside the program, in place of `string`.
+	// It is only read when the string would otherwise be pinned.
+	Source *Source `pulumi:"source,optional"`
+	// Generator generates the string to pin, in place of `string`. It runs whenever the
+	// string would otherwise be pinned.
+	Generator *Generator `pulumi:"generator,optional"`
+	// RotateOnDrift treats drift between the source and the pinned string, as found by
+	// the last refresh, as a trigger change.
+	RotateOnDrift *bool `pulumi:"rotateOnDrift,optional"`
+	// Transforms are applied in order to the pinned string to compute `result`.
+	Transforms []Transform `pulumi:"transforms,optional"`
+	// Constraints that a string must satisfy before it is pinned. They are enforced
+	// by Check, so a bad value fails before it ever reaches state.
+	Pattern       *string  `pulumi:"pattern,optional"`
+	MinLength     *int     `pulumi:"minLength,optional"`
+	MaxLength     *int     `pulumi:"maxLength,optional"`
+	AllowedValues []string `pulumi:"allowedValues,optional"`
+	Charset       *string  `pulumi:"charset,optional"`
+	// JSONSchema, when set, requires the string to be a JSON document matching the
+	// schema and exposes the decoded document as `parsed`.
+	JSONSchema *string `pulumi:"jsonSchema,optional"`
+	// RotationMode decides which input changes pin a new string.
+	RotationMode *RotationMode `pulumi:"rotationMode,optional"`
+	// ForceRotate is a one-shot nonce: any change to it pins the string regardless of
+	// triggers. In explicit mode it is the only way to pin a new string.
+	ForceRotate *string `pulumi:"forceRotate,optional"`
+	// RotationGroup joins the string to a group in the provider's rotation manifest.
+	// Editing the group's manifest entry counts as a trigger change for every member.
+	RotationGroup *string `pulumi:"rotationGroup,optional"`
+	// ExpiresAfter is a duration, such as `720h`, after which a pinned string expires.
+	// WarnBefore opens a window before expiry in which every diff warns, and OnExpiry
+	// decides what happens once the string has expired.
+	ExpiresAfter *string       `pulumi:"expiresAfter,optional"`
+	WarnBefore   *string       `pulumi:"warnBefore,optional"`
+	OnExpiry     *ExpiryPolicy `pulumi:"onExpiry,optional"`
+	// PreviousRetention is how long `previous` keeps the string replaced by a rotation,
+	// such as `1h`. It defaults to 24 hours.
+	PreviousRetention *string `pulumi:"previousRetention,optional"`
+	// Staged makes a rotation place the new string in `pending` rather than pin it. The
+	// pending string is pinned when the Promote nonce changes, or automatically on the
+	// PromoteAfterUpdates-th update after it was staged.
+	Staged              *bool   `pulumi:"staged,optional"`
+	Promote             *string `pulumi:"promote,optional"`
+	PromoteAfterUpdates *int    `pulumi:"promoteAfterUpdates,optional"`
+	// RetainOnDelete writes a tombstone of the pinned string to the provider's archive
+	// when the resource is deleted. RestoreFrom names a deleted resource of the same
+	// project and stack whose tombstoned string a new resource pins instead of its own.
+	RetainOnDelete *bool   `pulumi:"retainOnDelete,optional"`
+	RestoreFrom    *string `pulumi:"restoreFrom,optional"`
+	// Hashes selects the salted hashes of the pinned string to expose, such as
+	// `bcryptHash` for applications that store the hash rather than the string.
+	Hashes *HashOptions `pulumi:"hashes,optional"`
 }
 
 // Each resource has a state, describing the fields that exist on the created resource.
 type StatefulStringState struct {
 	// It is generally a good idea to embed args in outputs, but it isn't strictly necessary.
 	StatefulStringArgs
-	// Here we define a required output called result.
+	// Result is the pinned string after the transforms pipeline has been applied.
+	Result string `pulumi:"result,optional"`
+	// Parsed is the pinned string decoded as JSON. It is only set when a jsonSchema is given.
+	Parsed map[string]any `pulumi:"parsed,optional"`
+	// DesiredString is always the latest `string` input, even when it was not pinned.
+	DesiredString string `pulumi:"desiredString,optional"`
+	// IsStale is true when the latest `string` input differs from the pinned string.
+	IsStale bool `pulumi:"isStale,optional"`
+	// ExpiresAt is the RFC 3339 time at which the pinned string expires.
+	ExpiresAt *string `pulumi:"expiresAt,optional"`
+	// CurrentSourceValue is the value of the source as of the last refresh or rotation,
+	// and SourceDrift is true when it differs from the pinned string.
+	CurrentSourceValue *string `pulumi:"currentSourceValue,optional"`
+	SourceDrift        *bool   `pulumi:"sourceDrift,optional"`
+	// RotationGroupTrigger is the trigger value of the rotation group when the string
+	// was last updated.
+	RotationGroupTrigger *string `pulumi:"rotationGroupTrigger,optional"`
+	// Current is the pinned string. Previous is the string it replaced, kept until
+	// PreviousRetainedUntil so consumers can accept both during a cutover.
+	Current               string  `pulumi:"current,optional"`
+	Previous              *string `pulumi:"previous,optional"`
+	PreviousRetainedUntil *string `pulumi:"previousRetainedUntil,optional"`
+	// Pending is the string staged by a rotation and waiting to be promoted, and
+	// PendingUpdates counts the updates since it was staged.
+	Pending        *string `pulumi:"pending,optional"`
+	PendingUpdates *int    `pulumi:"pendingUpdates,optional"`
+	// Revision counts the strings that have been pinned, starting from 1.
+	Revision int `pulumi:"revision,optional"`
+	// The hashes of the pinned string selected by Hashes. They are only recomputed when
+	// the pinned string or their options change.
+	BcryptHash *string `pulumi:"bcryptHash,optional" provider:"secret"`
+	Argon2Hash *string `pulumi:"argon2Hash,optional" provider:"secret"`
+	ScryptHash *string `pulumi:"scryptHash,optional" provider:"secret"`
+}
+
+// WireDependencies keeps the default wiring, where every output depends on every input,
+// and additionally always treats the hashes of the pinned string as secret.
+func (ss StatefulString) WireDependencies(f infer.FieldSelector, args *StatefulStringArgs, state *StatefulStringState) {
+	f.OutputField(state).DependsOn(f.InputField(args))
+	f.OutputField(&state.BcryptHash).AlwaysSecret()
+	f.OutputField(&state.Argon2Hash).AlwaysSecret()
+	f.OutputField(&state.ScryptHash).AlwaysSecret()
+}
+
+func (ss *StatefulString) Annotate(a infer.Annotator) {
+	a.Describe(&ss, "A string that stays pinned to its first value until one of its triggers "+
+		"changes, at which point the latest `string` input is pinned instead.")
+}
+
+func (a *StatefulStringArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.String, "The string to pin. Changes are only picked up when the string rotates.")
+	an.Describe(&a.Triggers, "Arbitrary values that rotate the string whenever any of them is added, "+
+		"removed or changed. Values of any type are compared by content.")
+	an.Describe(&a.SensitiveTriggers, "Triggers whose values are masked, down to their last four "+
+		"characters, when a diff explains what changed. Secret trigger values are always hidden.")
+	an.Describe(&a.Source, "Where to read the string to pin from, in place of `string`. The source is "+
+		"only read when the string rotates.")
+	an.Describe(&a.Generator, "How to generate the string to pin, in place of `string`. A new string is "+
+		"only generated when the string rotates. A `derive` generator computes the same string from the "+
+		"same triggers, so rotating without changing a trigger keeps it.")
+	an.Describe(&a.RotateOnDrift, "Rotate when the last refresh found the source to differ from the "+
+		"pinned string. Defaults to `false`.")
+	an.Describe(&a.Transforms, "Transforms applied in order to the pinned string to compute `result`.")
+	an.Describe(&a.Pattern, "A regular expression the string must match before it is pinned.")
+	an.Describe(&a.MinLength, "The fewest characters the string may have before it is pinned.")
+	an.Describe(&a.MaxLength, "The most characters the string may have before it is pinned.")
+	an.Describe(&a.AllowedValues, "The only values the string may take before it is pinned.")
+	an.Describe(&a.Charset, "The characters the string may contain, written as the body of a regular "+
+		"expression character class such as `a-z0-9-`.")
+	an.Describe(&a.JSONSchema, "A JSON schema the string must satisfy as a JSON document. The decoded "+
+		"document is exposed as `parsed`.")
+	an.Describe(&a.RotationMode, "Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.")
+	an.Describe(&a.ForceRotate, "A nonce: any change to it pins the string regardless of triggers.")
+	an.Describe(&a.RotationGroup, "A group in the provider's rotation manifest. Editing the group's "+
+		"entry rotates every string in the group.")
+	an.Describe(&a.ExpiresAfter, "How long a pinned string lives, such as `720h`.")
+	an.Describe(&a.WarnBefore, "How long before expiry every diff starts to warn, such as `72h`.")
+	an.Describe(&a.OnExpiry, "What happens once the pinned string has expired. Defaults to `warn`.")
+	an.Describe(&a.PreviousRetention, "How long `previous` keeps the string replaced by a rotation, "+
+		"such as `1h`. Defaults to `24h`.")
+	an.Describe(&a.Staged, "Place a rotated string in `pending` and only pin it once promoted. "+
+		"Defaults to `false`.")
+	an.Describe(&a.Promote, "A nonce: any change to it pins the pending string.")
+	an.Describe(&a.PromoteAfterUpdates, "Pin the pending string automatically on this many updates "+
+		"after it was staged.")
+	an.Describe(&a.RetainOnDelete, "Write a tombstone of the pinned string to the provider's archive "+
+		"when the resource is deleted. Defaults to `false`.")
+	an.Describe(&a.RestoreFrom, "The name of a deleted resource in the same project and stack whose "+
+		"tombstone to restore the pinned string from on create.")
+	an.Describe(&a.Hashes, "The salted hashes of the pinned string to compute. A hash is only recomputed "+
+		"when the pinned string or its options change.")
+}
+
+func (s *StatefulStringState) Annotate(a infer.Annotator) {
+	a.Describe(&s.Result, "The pinned string after `transforms` have been applied.")
+	a.Describe(&s.Parsed, "The pinned string decoded as JSON. Only set when `jsonSchema` is given.")
+	a.Describe(&s.DesiredString, "The latest `string` input, even when it has not been pinned.")
+	a.Describe(&s.IsStale, "Whether the latest `string` input differs from the pinned string.")
+	a.Describe(&s.ExpiresAt, "The RFC 3339 time at which the pinned string expires.")
+	a.Describe(&s.CurrentSourceValue, "The value of the source as of the last refresh or rotation.")
+	a.Describe(&s.SourceDrift, "Whether the source differed from the pinned string at the last refresh.")
+	a.Describe(&s.RotationGroupTrigger, "The trigger value of the rotation group as of the last update.")
+	a.Describe(&s.Current, "The pinned string.")
+	a.Describe(&s.Previous, "The string replaced by the last rotation, until `previousRetainedUntil`.")
+	a.Describe(&s.PreviousRetainedUntil, "The RFC 3339 time until which `previous` is kept.")
+	a.Describe(&s.Pending, "The string staged by a rotation and waiting to be promoted.")
+	a.Describe(&s.PendingUpdates, "How many updates have happened since `pending` was staged.")
+	a.Describe(&s.Revision, "How many strings have been pinned, starting from 1.")
+	a.Describe(&s.BcryptHash, "The bcrypt hash of the pinned string, when `hashes.bcrypt` is set.")
+	a.Describe(&s.Argon2Hash, "The argon2id hash of the pinned string in PHC format, when "+
+		"`hashes.argon2` is set.")
+	a.Describe(&s.ScryptHash, "The scrypt hash of the pinned string in PHC format, when `hashes.scrypt` "+
+		"is set.")
 }
 
 // All resources must implement Create at a minimum.
 func (ss StatefulString) Create(ctx p.Context, name string, input StatefulStringArgs, preview bool) (id string, output StatefulStringState, err error) {
 	id = name
+	revision, restored := 1, false
+	if input.RestoreFrom != nil && !preview {
+		record, ok, err := restoreTombstone(ctx, input)
+		if err != nil {
+			return "", StatefulStringState{}, err
+		}
+		if ok {
+			// The restored string carries on from the revision it was deleted at
+			input.String = record.Value
+			revision, restored = record.Revision, true
+		} else {
+			ctx.Logf(diag.Warning, "%s: no tombstone %q to restore from; pinning a new string",
+				name, *input.RestoreFrom)
+		}
+	}
+	if input.external() && !preview && !restored {
+		input.String, err = externalString(ctx, name, input)
+		if err != nil {
+			return "", StatefulStringState{}, err
+		}
+	}
+	result, err := applyTransforms(input.String, input.Transforms)
+	if err != nil {
+		return "", StatefulStringState{}, err
+	}
 	output = StatefulStringState{
 		StatefulStringArgs: input,
+		Result:             result,
+		DesiredString:      input.String,
+		Current:            input.String,
+		Revision:           revision,
+	}
+	output.ExpiresAt, err = expiresAt(input, time.Now())
+	if err != nil {
+		return "", StatefulStringState{}, err
+	}
+	if input.Source != nil && !preview {
+		drift := false
+		output.CurrentSourceValue = &input.String
+		output.SourceDrift = &drift
+	}
+	output.RotationGroupTrigger, err = currentGroupTrigger(ctx, input)
+	if err != nil {
+		return "", StatefulStringState{}, err
+	}
+	if input.JSONSchema != nil && (!input.external() || !preview) {
+		output.Parsed, err = parseJSON(input.String)
+		if err != nil {
+			return "", StatefulStringState{}, err
+		}
+	}
+	if !input.external() || !preview {
+		if err := updateHashes(&output, StatefulStringState{}); err != nil {
+			return "", StatefulStringState{}, err
+		}
 	}
-	err = nil
 
 	return id, output, nil
 }
 
 type checkTriggerDiffAndUpdateResult struct {
 	triggerChanged     bool
+	rotate             bool
+	expiryChanged      bool
+	transformsChanged  bool
+	jsonSchemaChanged  bool
+	previousLapsed     bool
+	stage              bool
+	promote            bool
+	countPending       bool
+	pending            *string
+	triggerChanges     []triggerChange
+	stale              bool
 	changeMap          map[string]p.PropertyDiff
 	statefulStringArgs StatefulStringArgs
 }
 
-func checkTriggerDiffAndUpdate(olds StatefulStringState, news StatefulStringArgs) (result checkTriggerDiffAndUpdateResult, err error) {
-	// Assume no triggers have changed initially
+// groupTrigger is the current trigger value of the rotation group news belong to, if any.
+func checkTriggerDiffAndUpdate(olds StatefulStringState, news StatefulStringArgs, groupTrigger *string) (result checkTriggerDiffAndUpdateResult, err error) {
+	// Assume no triggers have changed initially, so the old string stays pinned
+	// while every other input is taken from news.
+	pinned := news
+	pinned.String = olds.String
 	r := checkTriggerDiffAndUpdateResult{
-		triggerChanged: false,
-		changeMap:      map[string]p.PropertyDiff{},
-		statefulStringArgs: StatefulStringArgs{
-			String:   olds.String,
-			Triggers: news.Triggers,
-		},
+		triggerChanged:     false,
+		changeMap:          map[string]p.PropertyDiff{},
+		statefulStringArgs: pinned,
 	}
 
-	// 1. Check if any new triggers have values different from old triggers or are newly added
-	for newKey, newValue := range news.Triggers {
-		oldValue, exists := olds.Triggers[newKey]
-		fullKey := "triggers." + newKey
-		if !exists {
-			r.changeMap[fullKey] = p.PropertyDiff{
-				Kind:      p.DiffKind("add"),
-				InputDiff: false,
-			}
-			// If a new trigger is added
-			r.triggerChanged = true
-		} else if newValue != oldValue {
-			// If an existing trigger's value has changed
-			r.triggerChanged = true
-			r.changeMap[fullKey] = p.PropertyDiff{
-				Kind:      p.DiffKind("update"),
-				InputDiff: false,
-			}
+	// Transforms never change the pinned string, only the result derived from it, and
+	// adding or removing a schema adds or removes the parsed output
+	r.transformsChanged = !transformsEqual(olds.Transforms, news.Transforms)
+	r.jsonSchemaChanged = !ptrEqual(olds.JSONSchema, news.JSONSchema)
+
+	r.triggerChanged = diffTriggers(olds.Triggers, news.Triggers, r.changeMap)
+	r.triggerChanges = triggerChanges(olds.Triggers, news.Triggers)
+
+	// A new manifest entry for the group is a trigger change shared by all its members.
+	// Joining or switching groups only records the group's current trigger.
+	if ptrEqual(olds.RotationGroup, news.RotationGroup) && olds.RotationGroupTrigger != nil &&
+		!ptrEqual(olds.RotationGroupTrigger, groupTrigger) {
+		r.triggerChanged = true
+		r.changeMap["rotationGroupTrigger"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
 		}
 	}
 
-	// 2. Check if any old triggers have been removed
-	for oldKey := range olds.Triggers {
-		if _, exists := news.Triggers[oldKey]; !exists {
-			fullKey := "triggers." + oldKey
-			// If an old trigger is removed
-			r.triggerChanged = true
-			r.changeMap[fullKey] = p.PropertyDiff{
-				Kind:      p.DiffKind("delete"),
-				InputDiff: false,
-			}
+	// Decide whether the changes rotate the pinned string
+	forceRotated := !ptrEqual(olds.ForceRotate, news.ForceRotate)
+	switch news.rotationMode() {
+	case RotateOnStringChange:
+		r.rotate = r.triggerChanged || news.String != olds.String
+	case RotateExplicit:
+		r.rotate = false
+	default:
+		r.rotate = r.triggerChanged
+	}
+	// A new forceRotate nonce rotates in every mode
+	r.rotate = r.rotate || forceRotated
+
+	// Changing the lifetime restarts the expiry clock, and under the rotate policy an
+	// expired string rotates on its own
+	r.expiryChanged = !ptrEqual(olds.ExpiresAfter, news.ExpiresAfter)
+	if status, ok := checkExpiryStatus(olds, news, time.Now()); ok && status.expired &&
+		news.ExpiresAfter != nil && news.onExpiry() == ExpiryRotate {
+		r.rotate = true
+		r.changeMap["expiresAt"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
 		}
 	}
 
-	// If a trigger has changed, update the string and triggers
-	if r.triggerChanged {
+	// Drift found by the last refresh counts as a trigger change when asked to
+	if news.Source != nil && isTrue(olds.SourceDrift) && isTrue(news.RotateOnDrift) {
+		r.rotate = true
+		r.changeMap["sourceDrift"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
+		}
+	}
+
+	// If the string rotates, update the string and triggers
+	if r.rotate {
 		r.statefulStringArgs = news
 		if news.String != olds.String {
 			r.changeMap["string"] = p.PropertyDiff{
@@ -139,71 +479,317 @@ func checkTriggerDiffAndUpdate(olds StatefulStringState, news StatefulStringArgs
 		}
 	}
 
+	r.stageRotation(olds, news)
+
+	// Once its grace period is over the previous string is dropped
+	if previousLapsed(olds, time.Now()) {
+		r.previousLapsed = true
+		r.changeMap["previous"] = p.PropertyDiff{
+			Kind:      p.DiffKind("delete"),
+			InputDiff: false,
+		}
+	}
+
+	// Every other input, such as a new source or generator that only takes effect on the
+	// next rotation, is persisted by an update that keeps the pinned string
+	diffInputs(olds.StatefulStringArgs, news, r.changeMap)
+
+	// Otherwise a new string is only recorded as desired, not pinned
+	if news.external() {
+		return r, nil
+	}
+	// A string waiting in pending is on its way, so it is not stale
+	r.stale = news.String != r.statefulStringArgs.String && !ptrEqual(r.pending, &news.String)
+	if !r.rotate && news.String != desiredString(olds) {
+		r.changeMap["desiredString"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
+		}
+	}
+
 	return r, nil
 }
 
+// external reports whether the string to pin comes from a source or a generator rather
+// than from the string input.
+func (a StatefulStringArgs) external() bool {
+	return a.Source != nil || a.Generator != nil
+}
+
+// externalString reads or generates the string to pin for an external args.
+func externalString(ctx p.Context, name string, args StatefulStringArgs) (string, error) {
+	if args.Source != nil {
+		return captureSource(ctx, args)
+	}
+	return generateString(ctx, name, args)
+}
+
+// desiredString returns the string input that olds was last updated with. State written
+// before desiredString existed only knows the pinned string.
+func desiredString(olds StatefulStringState) string {
+	if olds.DesiredString == "" {
+		return olds.String
+	}
+	return olds.DesiredString
+}
+
+// diffTriggers records a detailed diff entry in changeMap for every trigger value that was
+// added, changed or removed between olds and news, and reports whether there were any.
+func diffTriggers(olds, news map[string]any, changeMap map[string]p.PropertyDiff) (changed bool) {
+	for _, c := range triggerChanges(olds, news) {
+		changeMap["triggers."+c.path] = p.PropertyDiff{
+			Kind:      c.kind,
+			InputDiff: false,
+		}
+		changed = true
+	}
+	return changed
+}
+
+// diffInputs records an update in changeMap for every input other than the string and
+// its triggers that differs between olds and news, unless an entry already explains it.
+// An empty input is the same as a missing one.
+func diffInputs(olds, news StatefulStringArgs, changeMap map[string]p.PropertyDiff) {
+	o, n := reflect.ValueOf(olds), reflect.ValueOf(news)
+	for i := 0; i < o.NumField(); i++ {
+		key, _, _ := strings.Cut(o.Type().Field(i).Tag.Get("pulumi"), ",")
+		if key == "string" || key == "triggers" {
+			continue
+		}
+		if _, ok := changeMap[key]; ok || inputEqual(o.Field(i), n.Field(i)) {
+			continue
+		}
+		changeMap[key] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
+		}
+	}
+}
+
+func inputEqual(a, b reflect.Value) bool {
+	empty := func(v reflect.Value) bool {
+		switch v.Kind() {
+		case reflect.Slice, reflect.Map:
+			return v.Len() == 0
+		default:
+			return v.IsZero()
+		}
+	}
+	if empty(a) && empty(b) {
+		return true
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
 func (ss StatefulString) Update(ctx p.Context, name string, olds StatefulStringState, news StatefulStringArgs, preview bool) (output StatefulStringState, err error) {
-	d, _ := checkTriggerDiffAndUpdate(olds, news)
+	groupTrigger, err := currentGroupTrigger(ctx, news)
+	if err != nil {
+		return StatefulStringState{}, err
+	}
+	d, _ := checkTriggerDiffAndUpdate(olds, news, groupTrigger)
+
+	// A rotation reads a sourced string, or generates one, afresh. Previews keep the old
+	// one, so that nothing is read or run before the update is confirmed.
+	if d.rotate && news.external() {
+		captured := olds.String
+		if !preview {
+			captured, err = externalString(ctx, name, news)
+			if err != nil {
+				return StatefulStringState{}, err
+			}
+		}
+		switch {
+		case !d.stage:
+			d.statefulStringArgs.String = captured
+		case preview:
+			d.pending = olds.Pending
+		default:
+			d.pending = &captured
+		}
+	}
+	// Check only sees the inputs, so a rotation it could not foresee, such as one by the
+	// rotation group, would otherwise pin a literal string that breaks the constraints
+	if d.rotate && !news.external() && !preview {
+		if err := checkCaptured(news, news.String); err != nil {
+			return StatefulStringState{}, fmt.Errorf("%s: string is invalid: %w", name, err)
+		}
+	}
 
 	// If no triggers have changed, return the old string but with new triggers
-	return StatefulStringState{
+	output = StatefulStringState{
 		StatefulStringArgs: d.statefulStringArgs,
-	}, nil
+		Result:             olds.Result,
+		Parsed:             olds.Parsed,
+		DesiredString:      news.String,
+		IsStale:            d.stale,
+		ExpiresAt:          olds.ExpiresAt,
+		CurrentSourceValue: olds.CurrentSourceValue,
+		SourceDrift:        olds.SourceDrift,
+		// Always the group's latest trigger, so the next edit to the manifest is seen
+		RotationGroupTrigger:  groupTrigger,
+		Previous:              olds.Previous,
+		PreviousRetainedUntil: olds.PreviousRetainedUntil,
+		Pending:               d.pending,
+		PendingUpdates:        d.nextPendingUpdates(olds),
+	}
+
+	if news.external() {
+		output.DesiredString = output.String
+	}
+	if news.Source == nil {
+		output.CurrentSourceValue = nil
+		output.SourceDrift = nil
+	} else if d.replacesPinned() && !preview {
+		drift := false
+		output.CurrentSourceValue = &output.String
+		output.SourceDrift = &drift
+	}
+
+	if d.replacesPinned() || d.expiryChanged || olds.ExpiresAt == nil {
+		output.ExpiresAt, err = expiresAt(output.StatefulStringArgs, time.Now())
+		if err != nil {
+			return StatefulStringState{}, err
+		}
+	}
+
+	output.Current = output.String
+	output.Revision = max(olds.Revision, 1)
+	if output.String != olds.String {
+		output.Revision++
+		retainPrevious(&output, olds, time.Now())
+	} else if d.previousLapsed {
+		output.Previous = nil
+		output.PreviousRetainedUntil = nil
+	}
+
+	// Only recompute the result when the pinned string or the pipeline changed
+	if output.String != olds.String || d.transformsChanged || olds.Result == "" {
+		output.Result, err = applyTransforms(output.String, output.Transforms)
+		if err != nil {
+			return StatefulStringState{}, err
+		}
+	}
+	if output.JSONSchema == nil {
+		output.Parsed = nil
+	} else if output.String != olds.String || d.jsonSchemaChanged || olds.Parsed == nil {
+		output.Parsed, err = parseJSON(output.String)
+		if err != nil {
+			return StatefulStringState{}, err
+		}
+	}
+	if err := updateHashes(&output, olds); err != nil {
+		return StatefulStringState{}, err
+	}
+	return output, nil
+}
+
+// Read re-reads the source of a sourced string, recording drift from the pinned string
+// without rotating it.
+func (ss StatefulString) Read(ctx p.Context, id string, inputs StatefulStringArgs, state StatefulStringState) (
+	canonicalID string, normalizedInputs StatefulStringArgs, normalizedState StatefulStringState, err error) {
+	if state.Source == nil {
+		return id, inputs, state, nil
+	}
+	r, err := state.Source.reader()
+	if err == nil {
+		var value string
+		if value, err = r.Read(ctx); err == nil {
+			drift := value != state.String
+			state.CurrentSourceValue = &value
+			state.SourceDrift = &drift
+		}
+	}
+	// An unreadable source must not stop the rest of a refresh
+	if err != nil {
+		ctx.Logf(diag.Warning, "%s: could not read source to detect drift: %s", id, err)
+	}
+	return id, inputs, state, nil
 }
 
 func (ss StatefulString) Diff(ctx p.Context, name string, olds StatefulStringState, news StatefulStringArgs) (p.DiffResponse, error) {
-	d, _ := checkTriggerDiffAndUpdate(olds, news)
+	groupTrigger, err := currentGroupTrigger(ctx, news)
+	if err != nil {
+		return p.DiffResponse{}, err
+	}
+	d, _ := checkTriggerDiffAndUpdate(olds, news, groupTrigger)
+
+	if len(d.triggerChanges) > 0 {
+		ctx.Logf(diag.Info, "%s: triggers changed:\n%s", name,
+			explainTriggerChanges(d.triggerChanges, news.SensitiveTriggers, secretTriggers(ctx)))
+	}
+	if d.stale {
+		ctx.Logf(diag.Warning, "%s: the string input differs from the pinned string, which is kept "+
+			"until the next rotation; see desiredString and isStale", name)
+	}
+
+	// A rotation or a new lifetime resets the expiry, so only warn about the current one
+	if status, ok := checkExpiryStatus(olds, news, time.Now()); ok && !d.rotate && !d.expiryChanged {
+		at := status.at.Format(time.RFC3339)
+		switch {
+		case status.expired && news.onExpiry() == ExpiryFail:
+			return p.DiffResponse{}, fmt.Errorf("%s: the pinned string expired at %s; "+
+				"change a trigger or forceRotate to rotate it", name, at)
+		case status.expired:
+			ctx.Logf(diag.Warning, "%s: the pinned string expired at %s", name, at)
+		case status.warn:
+			ctx.Logf(diag.Warning, "%s: the pinned string expires at %s, in %s", name, at,
+				status.remaining.Round(time.Second))
+		}
+	}
 
 	return p.DiffResponse{
-		HasChanges:   d.triggerChanged,
+		HasChanges:   len(d.changeMap) > 0,
 		DetailedDiff: d.changeMap,
 	}, nil
 }
 
-//  actual  : provider.CheckResponse{Inputs:resource.PropertyMap{
-// "string":resource.PropertyValue{V:"1"}, "triggers":resource.PropertyValue{V:resource.PropertyMap{"foo":resource.PropertyValue{V:"barX"},
-// "foo3":resource.PropertyValue{V:"bar3"}}}}, Failures:[]provider.CheckFailure(nil)}
-
-// func (ss StatefulString) Check(ctx p.Context, name string, olds resource.PropertyMap, news resource.PropertyMap) (StatefulStringArgs, []p.CheckFailure, error) {
-// 	// Extract the string property
-// 	stringProp, stringOk := news["string"]
-// 	if !stringOk || stringProp.IsNull() {
-// 		// If the string property is not present in the new inputs or is nil, return a CheckFailure
-// 		return StatefulStringArgs{}, []p.CheckFailure{
-// 			{
-// 				Property: "string",
-// 				Reason:   "string property is required",
-// 			},
-// 		}, nil
-// 	}
-
-// 	// Extract the triggers property from the new inputs
-// 	triggersProp, triggersOk := news["triggers"]
-// 	if !triggersOk || triggersProp.IsNull() {
-// 		// If the triggers property is not present in the new inputs or is nil, return a CheckFailure
-// 		return StatefulStringArgs{}, []p.CheckFailure{
-// 			{
-// 				Property: "triggers",
-// 				Reason:   "triggers property is required",
-// 			},
-// 		}, nil
-// 	}
-
-// 	// Convert the properties to their underlying types
-// 	stringValue := stringProp.StringValue()
-// 	triggersValue := triggersProp.ObjectValue()
-
-// 	// Convert the triggers map to a map[string]string
-// 	triggersMap := make(map[string]string)
-// 	for key, value := range triggersValue {
-// 		triggersMap[string(key)] = value.StringValue()
-// 	}
-
-// 	// Create a new StatefulStringArgs instance
-// 	args := StatefulStringArgs{
-// 		String:   stringValue,
-// 		Triggers: triggersMap,
-// 	}
-
-// 	return args, nil, nil
-// }
+func (ss StatefulString) Check(ctx p.Context, name string, olds resource.PropertyMap, news resource.PropertyMap) (StatefulStringArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[StatefulStringArgs](news)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+
+	if _, err := applyTransforms(args.String, args.Transforms); err != nil {
+		failures = append(failures, p.CheckFailure{
+			Property: "transforms",
+			Reason:   err.Error(),
+		})
+	}
+	failures = append(failures, checkExpiry(args)...)
+	failures = append(failures, checkRetention(args)...)
+	failures = append(failures, checkStaging(args)...)
+	failures = append(failures, checkSource(args, news.HasValue("string"))...)
+	failures = append(failures, checkGenerator(ctx, args, news.HasValue("string"))...)
+	failures = append(failures, checkHashes(args)...)
+	if args.RotationGroup != nil && !news["rotationGroup"].ContainsUnknowns() {
+		if _, err := currentGroupTrigger(ctx, args); err != nil {
+			failures = append(failures, p.CheckFailure{Property: "rotationGroup", Reason: err.Error()})
+		}
+	}
+
+	// The constraints only apply to the value that is about to be pinned. An unknown
+	// value is checked again once it is known, and a sourced or generated one once it
+	// is read or generated.
+	if !args.external() && !news.ContainsUnknowns() && pinsNewValue(olds, args) {
+		failures = append(failures, checkConstraints(args)...)
+		failures = append(failures, checkJSONSchema(args)...)
+	}
+
+	return args, failures, nil
+}
+
+// pinsNewValue reports whether applying news on top of the previous inputs olds would
+// pin the new string, either because the resource is being created or because
+// checkTriggerDiffAndUpdate decides to rotate.
+func pinsNewValue(olds resource.PropertyMap, news StatefulStringArgs) bool {
+	if len(olds) == 0 {
+		return true
+	}
+	oldArgs, _, err := infer.DefaultCheck[StatefulStringArgs](olds)
+	if err != nil {
+		return true
+	}
+	// The group trigger is only kept in state, so only the inputs can rotate here
+	d, _ := checkTriggerDiffAndUpdate(StatefulStringState{StatefulStringArgs: oldArgs}, news, nil)
+	return d.rotate
+}
@@ -0,0 +1,71 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// How long the previous value is kept after a rotation when previousRetention is not set.
+const defaultPreviousRetention = 24 * time.Hour
+
+// previousRetention returns how long the previous value is kept after a rotation.
+func (a StatefulStringArgs) previousRetention() time.Duration {
+	if a.PreviousRetention == nil {
+		return defaultPreviousRetention
+	}
+	d, err := time.ParseDuration(*a.PreviousRetention)
+	if err != nil {
+		return defaultPreviousRetention
+	}
+	return d
+}
+
+// checkRetention validates the previousRetention input of args.
+func checkRetention(args StatefulStringArgs) []p.CheckFailure {
+	if args.PreviousRetention == nil {
+		return nil
+	}
+	if d, err := time.ParseDuration(*args.PreviousRetention); err != nil {
+		return []p.CheckFailure{{Property: "previousRetention", Reason: err.Error()}}
+	} else if d <= 0 {
+		return []p.CheckFailure{{Property: "previousRetention", Reason: "duration must be positive"}}
+	}
+	return nil
+}
+
+// previousLapsed reports whether olds holds a previous value whose grace period ended
+// before now.
+func previousLapsed(olds StatefulStringState, now time.Time) bool {
+	if olds.Previous == nil || olds.PreviousRetainedUntil == nil {
+		return false
+	}
+	until, err := time.Parse(time.RFC3339, *olds.PreviousRetainedUntil)
+	if err != nil {
+		return false
+	}
+	return !now.Before(until)
+}
+
+// retainPrevious records the string pinned in olds as the previous value of output, which
+// has just rotated to a new string at now.
+func retainPrevious(output *StatefulStringState, olds StatefulStringState, now time.Time) {
+	previous := olds.String
+	until := now.Add(output.previousRetention()).UTC().Format(time.RFC3339)
+	output.Previous = &previous
+	output.PreviousRetainedUntil = &until
+}
@@ -0,0 +1,47 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// RotationMode decides which input changes replace the pinned string.
+type RotationMode string
+
+const (
+	RotateOnTriggerChange RotationMode = "rotateOnTriggerChange"
+	RotateOnStringChange  RotationMode = "rotateOnStringChange"
+	RotateExplicit        RotationMode = "explicit"
+)
+
+func (RotationMode) Values() []infer.EnumValue[RotationMode] {
+	return []infer.EnumValue[RotationMode]{
+		{Name: "RotateOnTriggerChange", Value: RotateOnTriggerChange,
+			Description: "Pin the string whenever a trigger is added, removed or changed. This is the default."},
+		{Name: "RotateOnStringChange", Value: RotateOnStringChange,
+			Description: "Pin the string whenever it or a trigger changes."},
+		{Name: "Explicit", Value: RotateExplicit,
+			Description: "Pin the string only when the forceRotate nonce changes."},
+	}
+}
+
+// rotationMode returns the configured rotation mode, defaulting to rotating on trigger changes.
+func (a StatefulStringArgs) rotationMode() RotationMode {
+	if a.RotationMode == nil {
+		return RotateOnTriggerChange
+	}
+	return *a.RotationMode
+}
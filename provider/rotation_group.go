@@ -0,0 +1,117 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// A rotation manifest is a JSON object mapping each rotation group to an arbitrary JSON
+// value, such as `{"db-credentials": {"generation": 3}}`. Editing a group's value rotates
+// every StatefulString in that group in the same update.
+
+// rotationGroupTrigger computes the trigger value of group from the manifest at path: a
+// hash of the group's entry, so any edit to the entry changes it.
+func rotationGroupTrigger(path, group string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading rotation manifest: %w", err)
+	}
+	var manifest map[string]any
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return "", fmt.Errorf("rotation manifest %s must be a JSON object: %w", path, err)
+	}
+	entry, ok := manifest[group]
+	if !ok {
+		return "", fmt.Errorf("rotation group %q is not in the rotation manifest %s", group, path)
+	}
+	// encoding/json sorts object keys, so equal entries always hash the same
+	canonical, err := json.Marshal(entry)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// rotationManifest returns the path of the configured rotation manifest.
+func (c Config) rotationManifest() (string, error) {
+	if c.RotationManifest == nil || *c.RotationManifest == "" {
+		return "", errors.New("rotation groups require the provider's rotationManifest config")
+	}
+	return *c.RotationManifest, nil
+}
+
+// currentGroupTrigger returns the trigger value of the rotation group args belong to, or
+// nil when they are not in one.
+func currentGroupTrigger(ctx p.Context, args StatefulStringArgs) (*string, error) {
+	if args.RotationGroup == nil {
+		return nil, nil
+	}
+	path, err := getConfig(ctx).rotationManifest()
+	if err != nil {
+		return nil, err
+	}
+	trigger, err := rotationGroupTrigger(path, *args.RotationGroup)
+	if err != nil {
+		return nil, err
+	}
+	return &trigger, nil
+}
+
+// GetRotationGroupTrigger computes the shared trigger value of a rotation group from the
+// provider's rotation manifest, for use as a trigger on resources outside the group.
+type GetRotationGroupTrigger struct{}
+
+type GetRotationGroupTriggerArgs struct {
+	Group string `pulumi:"group"`
+}
+
+type GetRotationGroupTriggerResult struct {
+	Trigger string `pulumi:"trigger"`
+}
+
+func (g *GetRotationGroupTrigger) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Computes the shared trigger value of a rotation group from the provider's rotation "+
+		"manifest, for use as a trigger on resources outside the group.")
+}
+
+func (a *GetRotationGroupTriggerArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Group, "The name of the group in the rotation manifest.")
+}
+
+func (r *GetRotationGroupTriggerResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.Trigger, "The trigger value of the group, which changes whenever its manifest entry does.")
+}
+
+func (GetRotationGroupTrigger) Call(ctx p.Context, args GetRotationGroupTriggerArgs) (GetRotationGroupTriggerResult, error) {
+	path, err := getConfig(ctx).rotationManifest()
+	if err != nil {
+		return GetRotationGroupTriggerResult{}, err
+	}
+	trigger, err := rotationGroupTrigger(path, args.Group)
+	if err != nil {
+		return GetRotationGroupTriggerResult{}, err
+	}
+	return GetRotationGroupTriggerResult{Trigger: trigger}, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// SharedStatefulString pins a string like StatefulString and publishes it, with its
+// triggers, to the provider's shared store. The stack that creates it owns rotation;
+// other stacks read the value with the getSharedStatefulString invoke.
+type SharedStatefulString struct{}
+
+type SharedStatefulStringArgs struct {
+	// Key names the record in the shared store.
+	Key      string         `pulumi:"key"`
+	String   string         `pulumi:"string"`
+	Triggers map[string]any `pulumi:"triggers,optional"`
+}
+
+type SharedStatefulStringState struct {
+	SharedStatefulStringArgs
+	// OwnerID identifies this resource as the owner of the record in the shared store.
+	OwnerID string `pulumi:"ownerId"`
+}
+
+func (ss *SharedStatefulString) Annotate(a infer.Annotator) {
+	a.Describe(&ss, "A string that stays pinned until one of its triggers changes, published with its "+
+		"triggers to the provider's shared store so that other stacks can read it with "+
+		"`getSharedStatefulString`.")
+}
+
+func (a *SharedStatefulStringArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Key, "The name of the record in the shared store. Changing it replaces the resource.")
+	an.Describe(&a.String, "The string to pin. Changes are only picked up when a trigger changes.")
+	an.Describe(&a.Triggers, "Arbitrary values that rotate the string whenever any of them is added, "+
+		"removed or changed.")
+}
+
+func (s *SharedStatefulStringState) Annotate(a infer.Annotator) {
+	a.Describe(&s.OwnerID, "Identifies this resource as the owner of the record in the shared store.")
+}
+
+// WireDependencies keeps the default wiring, where every output depends on every input,
+// and additionally always treats the shared string as secret.
+func (ss SharedStatefulString) WireDependencies(f infer.FieldSelector, args *SharedStatefulStringArgs, state *SharedStatefulStringState) {
+	f.OutputField(state).DependsOn(f.InputField(args))
+	f.OutputField(&state.String).AlwaysSecret()
+}
+
+func (ss SharedStatefulString) Check(ctx p.Context, name string, olds resource.PropertyMap, news resource.PropertyMap) (SharedStatefulStringArgs, []p.CheckFailure, error) {
+	args, failures, err := infer.DefaultCheck[SharedStatefulStringArgs](news)
+	if err != nil || len(failures) > 0 {
+		return args, failures, err
+	}
+	if !news["key"].ContainsUnknowns() {
+		if err := checkStoreKey(args.Key); err != nil {
+			failures = append(failures, p.CheckFailure{Property: "key", Reason: err.Error()})
+		}
+	}
+	return args, failures, nil
+}
+
+func (ss SharedStatefulString) Create(ctx p.Context, name string, input SharedStatefulStringArgs, preview bool) (id string, output SharedStatefulStringState, err error) {
+	output = SharedStatefulStringState{SharedStatefulStringArgs: input}
+	if preview {
+		return name, output, nil
+	}
+
+	owner := make([]byte, 16)
+	if _, err := rand.Read(owner); err != nil {
+		return "", output, err
+	}
+	output.OwnerID = hex.EncodeToString(owner)
+
+	if err := publishShared(ctx, output); err != nil {
+		return "", output, err
+	}
+	return name, output, nil
+}
+
+func (ss SharedStatefulString) Diff(ctx p.Context, name string, olds SharedStatefulStringState, news SharedStatefulStringArgs) (p.DiffResponse, error) {
+	changeMap := map[string]p.PropertyDiff{}
+	if diffTriggers(olds.Triggers, news.Triggers, changeMap) && news.String != olds.String {
+		changeMap["string"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
+		}
+	}
+	// A new key is a different record, which the new resource has to claim
+	if news.Key != olds.Key {
+		changeMap["key"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update&replace"),
+			InputDiff: false,
+		}
+	}
+
+	return p.DiffResponse{
+		HasChanges:          len(changeMap) > 0,
+		DetailedDiff:        changeMap,
+		DeleteBeforeReplace: true,
+	}, nil
+}
+
+func (ss SharedStatefulString) Update(ctx p.Context, name string, olds SharedStatefulStringState, news SharedStatefulStringArgs, preview bool) (SharedStatefulStringState, error) {
+	output := olds
+	if diffTriggers(olds.Triggers, news.Triggers, map[string]p.PropertyDiff{}) {
+		output.String = news.String
+	}
+	output.Triggers = news.Triggers
+	if preview {
+		return output, nil
+	}
+	if err := publishShared(ctx, output); err != nil {
+		return olds, err
+	}
+	return output, nil
+}
+
+func (ss SharedStatefulString) Delete(ctx p.Context, id string, props SharedStatefulStringState) error {
+	store, err := getConfig(ctx).sharedStore()
+	if err != nil {
+		return err
+	}
+	return store.Update(props.Key, func(current *SharedRecord) (*SharedRecord, error) {
+		// Leave records this resource does not own alone
+		if current != nil && current.Owner != props.OwnerID {
+			return current, nil
+		}
+		return nil, nil
+	})
+}
+
+// publishShared writes state to the shared store, refusing to overwrite a record owned
+// by another resource.
+func publishShared(ctx p.Context, state SharedStatefulStringState) error {
+	store, err := getConfig(ctx).sharedStore()
+	if err != nil {
+		return err
+	}
+	return store.Update(state.Key, func(current *SharedRecord) (*SharedRecord, error) {
+		if current != nil && current.Owner != state.OwnerID {
+			return nil, fmt.Errorf("shared string %q is owned by another stack; "+
+				"read it with getSharedStatefulString instead", state.Key)
+		}
+		return &SharedRecord{
+			Value:     state.String,
+			Triggers:  state.Triggers,
+			Owner:     state.OwnerID,
+			UpdatedAt: time.Now().UTC().Format(time.RFC3339),
+		}, nil
+	})
+}
+
+// GetSharedStatefulString reads a string published by a SharedStatefulString, possibly
+// from another stack.
+type GetSharedStatefulString struct{}
+
+type GetSharedStatefulStringArgs struct {
+	Key string `pulumi:"key"`
+}
+
+type GetSharedStatefulStringResult struct {
+	String    string         `pulumi:"string" provider:"secret"`
+	Triggers  map[string]any `pulumi:"triggers,optional"`
+	UpdatedAt string         `pulumi:"updatedAt"`
+}
+
+func (g *GetSharedStatefulString) Annotate(a infer.Annotator) {
+	a.Describe(&g, "Reads a string published by a SharedStatefulString, possibly from another stack.")
+}
+
+func (a *GetSharedStatefulStringArgs) Annotate(an infer.Annotator) {
+	an.Describe(&a.Key, "The name of the record in the shared store.")
+}
+
+func (r *GetSharedStatefulStringResult) Annotate(a infer.Annotator) {
+	a.Describe(&r.String, "The published string.")
+	a.Describe(&r.Triggers, "The triggers the string was last pinned with.")
+	a.Describe(&r.UpdatedAt, "The RFC 3339 time at which the record was last published.")
+}
+
+func (GetSharedStatefulString) Call(ctx p.Context, args GetSharedStatefulStringArgs) (GetSharedStatefulStringResult, error) {
+	store, err := getConfig(ctx).sharedStore()
+	if err != nil {
+		return GetSharedStatefulStringResult{}, err
+	}
+	record, ok, err := store.Get(args.Key)
+	if err != nil {
+		return GetSharedStatefulStringResult{}, err
+	}
+	if !ok {
+		return GetSharedStatefulStringResult{}, fmt.Errorf("no shared string has been published under %q", args.Key)
+	}
+	return GetSharedStatefulStringResult{
+		String:    record.Value,
+		Triggers:  record.Triggers,
+		UpdatedAt: record.UpdatedAt,
+	}, nil
+}
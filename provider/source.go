@@ -0,0 +1,173 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// SourceKind names the kind of external source a pinned string is read from.
+type SourceKind string
+
+const (
+	SourceEnv     SourceKind = "env"
+	SourceFile    SourceKind = "file"
+	SourceCommand SourceKind = "command"
+)
+
+func (SourceKind) Values() []infer.EnumValue[SourceKind] {
+	return []infer.EnumValue[SourceKind]{
+		{Name: "Env", Value: SourceEnv, Description: "Read the environment variable `name` of the provider process."},
+		{Name: "File", Value: SourceFile, Description: "Read the local file at `path`."},
+		{Name: "Command", Value: SourceCommand, Description: "Run `command` and read its standard output."},
+	}
+}
+
+// A Source describes where to read the string to pin from, in place of a literal `string`.
+type Source struct {
+	Kind    SourceKind `pulumi:"kind"`
+	Name    *string    `pulumi:"name,optional"`
+	Path    *string    `pulumi:"path,optional"`
+	Command []string   `pulumi:"command,optional"`
+}
+
+func (s *Source) Annotate(a infer.Annotator) {
+	a.Describe(&s.Kind, "The kind of source.")
+	a.Describe(&s.Name, "The environment variable an `env` source reads.")
+	a.Describe(&s.Path, "The file a `file` source reads.")
+	a.Describe(&s.Command, "The program and arguments a `command` source runs.")
+}
+
+// A sourceReader fetches the current value of an external source.
+type sourceReader interface {
+	Read(ctx context.Context) (string, error)
+}
+
+// reader returns the sourceReader for s.
+func (s Source) reader() (sourceReader, error) {
+	switch s.Kind {
+	case SourceEnv:
+		if s.Name == nil || *s.Name == "" {
+			return nil, errors.New("an env source requires a name")
+		}
+		return envSource{name: *s.Name}, nil
+	case SourceFile:
+		if s.Path == nil || *s.Path == "" {
+			return nil, errors.New("a file source requires a path")
+		}
+		return fileSource{path: *s.Path}, nil
+	case SourceCommand:
+		if len(s.Command) == 0 {
+			return nil, errors.New("a command source requires a command")
+		}
+		return commandSource{argv: s.Command}, nil
+	default:
+		return nil, fmt.Errorf("unknown source kind %q", s.Kind)
+	}
+}
+
+type envSource struct{ name string }
+
+func (s envSource) Read(context.Context) (string, error) {
+	value, ok := os.LookupEnv(s.name)
+	if !ok {
+		return "", fmt.Errorf("environment variable %s is not set", s.name)
+	}
+	return value, nil
+}
+
+type fileSource struct{ path string }
+
+func (s fileSource) Read(context.Context) (string, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+type commandSource struct{ argv []string }
+
+func (s commandSource) Read(ctx context.Context) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.argv[0], s.argv[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%s: %w: %s", s.argv[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
+
+// captureSource reads the string to pin for args from its source, holding it to the
+// same constraints Check applies to a literal string.
+func captureSource(ctx p.Context, args StatefulStringArgs) (string, error) {
+	r, err := args.Source.reader()
+	if err != nil {
+		return "", fmt.Errorf("source: %w", err)
+	}
+	value, err := r.Read(ctx)
+	if err != nil {
+		return "", fmt.Errorf("reading source: %w", err)
+	}
+
+	if err := checkCaptured(args, value); err != nil {
+		return "", fmt.Errorf("value read from source is invalid: %w", err)
+	}
+	return value, nil
+}
+
+// checkCaptured holds a value that Check never saw, either because it did not come from
+// the string input or because Check could not tell it was about to be pinned, to the
+// constraints of args.
+func checkCaptured(args StatefulStringArgs, value string) error {
+	args.String = value
+	failures := append(checkConstraints(args), checkJSONSchema(args)...)
+	if len(failures) == 0 {
+		return nil
+	}
+	reasons := make([]string, len(failures))
+	for i, f := range failures {
+		reasons[i] = f.Reason
+	}
+	return errors.New(strings.Join(reasons, "; "))
+}
+
+// checkSource validates the source input of args. hasString reports whether the raw
+// inputs carry a literal string, which cannot be combined with a source.
+func checkSource(args StatefulStringArgs, hasString bool) []p.CheckFailure {
+	if args.Source == nil {
+		return nil
+	}
+	if hasString {
+		return []p.CheckFailure{{Property: "source", Reason: "string and source are mutually exclusive"}}
+	}
+	if args.Generator != nil {
+		return []p.CheckFailure{{Property: "source", Reason: "source and generator are mutually exclusive"}}
+	}
+	if _, err := args.Source.reader(); err != nil {
+		return []p.CheckFailure{{Property: "source", Reason: err.Error()}}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	p "github.com/pulumi/pulumi-go-provider"
+)
+
+// A staged rotation happens in two phases. The rotation itself only places the new
+// string in `pending`, while `string` keeps the old one. A later change to the `promote`
+// nonce, or the update that completes `promoteAfterUpdates`, then pins the pending string.
+
+// stageRotation turns the rotation r has decided on into a staged one when news asks
+// for it, and promotes a pending string from olds when it is due.
+func (r *checkTriggerDiffAndUpdateResult) stageRotation(olds StatefulStringState, news StatefulStringArgs) {
+	r.pending = olds.Pending
+
+	if !ptrEqual(olds.Promote, news.Promote) {
+		r.changeMap["promote"] = p.PropertyDiff{
+			Kind:      p.DiffKind("update"),
+			InputDiff: false,
+		}
+	}
+
+	// Promote the string staged by an earlier rotation
+	if olds.Pending != nil {
+		due := news.PromoteAfterUpdates != nil && pendingUpdates(olds)+1 >= *news.PromoteAfterUpdates
+		if !ptrEqual(olds.Promote, news.Promote) || due {
+			r.promote = true
+			r.pending = nil
+			r.statefulStringArgs.String = *olds.Pending
+			r.changeMap["pending"] = p.PropertyDiff{
+				Kind:      p.DiffKind("delete"),
+				InputDiff: false,
+			}
+			if *olds.Pending != olds.String {
+				r.changeMap["string"] = p.PropertyDiff{
+					Kind:      p.DiffKind("update"),
+					InputDiff: false,
+				}
+			}
+		} else if news.PromoteAfterUpdates != nil {
+			// Every update counts towards promotion, so the countdown needs one
+			r.countPending = true
+			r.changeMap["pendingUpdates"] = p.PropertyDiff{
+				Kind:      p.DiffKind("update"),
+				InputDiff: false,
+			}
+		}
+	}
+
+	// Stage the new string instead of pinning it
+	if r.rotate && isTrue(news.Staged) {
+		pending := news.String
+		r.stage = true
+		r.countPending = false
+		r.pending = &pending
+		if !r.promote {
+			r.statefulStringArgs.String = olds.String
+		}
+		if r.statefulStringArgs.String == olds.String {
+			delete(r.changeMap, "string")
+		}
+		kind := "add"
+		if olds.Pending != nil {
+			kind = "update"
+		}
+		r.changeMap["pending"] = p.PropertyDiff{
+			Kind:      p.DiffKind(kind),
+			InputDiff: false,
+		}
+	}
+}
+
+// replacesPinned reports whether the update r describes pins a new string, either by an
+// ordinary rotation or by promoting a pending one.
+func (r checkTriggerDiffAndUpdateResult) replacesPinned() bool {
+	return (r.rotate && !r.stage) || r.promote
+}
+
+// pendingUpdates returns how many updates olds has seen since its pending string was staged.
+func pendingUpdates(olds StatefulStringState) int {
+	if olds.PendingUpdates == nil {
+		return 0
+	}
+	return *olds.PendingUpdates
+}
+
+// nextPendingUpdates returns the pendingUpdates output of an update described by r.
+func (r checkTriggerDiffAndUpdateResult) nextPendingUpdates(olds StatefulStringState) *int {
+	var n int
+	switch {
+	case r.stage:
+		n = 0
+	case r.pending == nil:
+		return nil
+	case r.countPending:
+		n = pendingUpdates(olds) + 1
+	default:
+		return olds.PendingUpdates
+	}
+	return &n
+}
+
+// checkStaging validates the staged rotation inputs of args.
+func checkStaging(args StatefulStringArgs) []p.CheckFailure {
+	if args.PromoteAfterUpdates != nil && *args.PromoteAfterUpdates < 1 {
+		return []p.CheckFailure{{Property: "promoteAfterUpdates", Reason: "promoteAfterUpdates must be at least 1"}}
+	}
+	return nil
+}
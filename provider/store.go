@@ -0,0 +1,175 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// A SharedRecord is what a SharedStatefulString publishes for other stacks to read. The
+// tombstones a StatefulString leaves in the archive are records too, carrying the
+// revision of the deleted string.
+type SharedRecord struct {
+	Value     string         `json:"value"`
+	Triggers  map[string]any `json:"triggers"`
+	Owner     string         `json:"owner"`
+	UpdatedAt string         `json:"updatedAt"`
+	Revision  int            `json:"revision,omitempty"`
+}
+
+// A Store persists shared records by key. Implementations must be safe to use from
+// several provider processes at once, since every stack runs its own provider.
+type Store interface {
+	// Get returns the record stored under key. ok is false when there is none.
+	Get(key string) (record SharedRecord, ok bool, err error)
+	// Update atomically replaces the record under key with the result of fn. current is
+	// nil when there is no record yet. Returning a nil record deletes the key.
+	Update(key string, fn func(current *SharedRecord) (*SharedRecord, error)) error
+}
+
+// Keys double as file names, so they are restricted to a portable alphabet.
+var storeKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+func checkStoreKey(key string) error {
+	if !storeKeyPattern.MatchString(key) {
+		return fmt.Errorf("key %q must start with a letter or digit and contain only letters, digits, '.', '_' and '-'", key)
+	}
+	return nil
+}
+
+// How long the file store waits for a lock, and how old a lock file must be before it
+// is assumed to belong to a process that died while holding it.
+const (
+	fileStoreLockTimeout = 10 * time.Second
+	fileStoreStaleLock   = time.Minute
+)
+
+// fileStore is a Store backed by one JSON file per key in a local directory. Writers
+// serialize on a lock file created next to the record.
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore returns a Store that keeps its records in dir.
+func NewFileStore(dir string) Store {
+	return fileStore{dir: dir}
+}
+
+func (s fileStore) path(key string) string {
+	return filepath.Join(s.dir, key+".json")
+}
+
+func (s fileStore) Get(key string) (SharedRecord, bool, error) {
+	if err := checkStoreKey(key); err != nil {
+		return SharedRecord{}, false, err
+	}
+	record, err := s.read(key)
+	if err != nil || record == nil {
+		return SharedRecord{}, false, err
+	}
+	return *record, true, nil
+}
+
+func (s fileStore) Update(key string, fn func(current *SharedRecord) (*SharedRecord, error)) error {
+	if err := checkStoreKey(key); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+	unlock, err := s.lock(key)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	current, err := s.read(key)
+	if err != nil {
+		return err
+	}
+	next, err := fn(current)
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(next, "", "  ")
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so readers never observe a partial record
+	tmp, err := os.CreateTemp(s.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(key))
+}
+
+func (s fileStore) read(key string) (*SharedRecord, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var record SharedRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("reading shared record %q: %w", key, err)
+	}
+	return &record, nil
+}
+
+// lock takes the lock for key, returning a function that releases it.
+func (s fileStore) lock(key string) (unlock func(), err error) {
+	path := filepath.Join(s.dir, key+".lock")
+	deadline := time.Now().Add(fileStoreLockTimeout)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > fileStoreStaleLock {
+			os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock %s", path)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
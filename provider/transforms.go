@@ -0,0 +1,137 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/pulumi-go-provider/infer"
+)
+
+// The length a `truncate` transform uses when none is given: the longest valid DNS label.
+const defaultTruncateLength = 63
+
+// TransformKind names a single step of the pinned value transformation pipeline.
+type TransformKind string
+
+const (
+	TransformLower    TransformKind = "lower"
+	TransformUpper    TransformKind = "upper"
+	TransformTruncate TransformKind = "truncate"
+	TransformSlugify  TransformKind = "slugify"
+	TransformPrefix   TransformKind = "prefix"
+	TransformSuffix   TransformKind = "suffix"
+	TransformBase64   TransformKind = "base64"
+)
+
+func (TransformKind) Values() []infer.EnumValue[TransformKind] {
+	return []infer.EnumValue[TransformKind]{
+		{Name: "Lower", Value: TransformLower, Description: "Lowercase the value."},
+		{Name: "Upper", Value: TransformUpper, Description: "Uppercase the value."},
+		{Name: "Truncate", Value: TransformTruncate, Description: "Keep at most `length` characters (63 by default)."},
+		{Name: "Slugify", Value: TransformSlugify, Description: "Reduce the value to lowercase letters, digits and single dashes."},
+		{Name: "Prefix", Value: TransformPrefix, Description: "Prepend `value`."},
+		{Name: "Suffix", Value: TransformSuffix, Description: "Append `value`."},
+		{Name: "Base64", Value: TransformBase64, Description: "Standard base64 encode the value."},
+	}
+}
+
+// A Transform is one step of the ordered pipeline that derives `result` from the pinned string.
+type Transform struct {
+	Kind   TransformKind `pulumi:"kind"`
+	Length *int          `pulumi:"length,optional"`
+	Value  *string       `pulumi:"value,optional"`
+}
+
+func (t *Transform) Annotate(a infer.Annotator) {
+	a.Describe(&t.Kind, "The transform to apply.")
+	a.Describe(&t.Length, "The length a `truncate` transform keeps.")
+	a.SetDefault(&t.Length, defaultTruncateLength)
+	a.Describe(&t.Value, "The text a `prefix` or `suffix` transform adds.")
+}
+
+// applyTransforms runs s through each transform in order.
+func applyTransforms(s string, transforms []Transform) (string, error) {
+	for i, t := range transforms {
+		switch t.Kind {
+		case TransformLower:
+			s = strings.ToLower(s)
+		case TransformUpper:
+			s = strings.ToUpper(s)
+		case TransformTruncate:
+			length := defaultTruncateLength
+			if t.Length != nil {
+				length = *t.Length
+			}
+			if length < 0 {
+				return "", fmt.Errorf("transforms[%d]: length must not be negative", i)
+			}
+			if r := []rune(s); len(r) > length {
+				s = string(r[:length])
+			}
+		case TransformSlugify:
+			s = slugify(s)
+		case TransformPrefix:
+			if t.Value == nil {
+				return "", fmt.Errorf("transforms[%d]: prefix requires a value", i)
+			}
+			s = *t.Value + s
+		case TransformSuffix:
+			if t.Value == nil {
+				return "", fmt.Errorf("transforms[%d]: suffix requires a value", i)
+			}
+			s = s + *t.Value
+		case TransformBase64:
+			s = base64.StdEncoding.EncodeToString([]byte(s))
+		default:
+			return "", fmt.Errorf("transforms[%d]: unknown transform kind %q", i, t.Kind)
+		}
+	}
+	return s, nil
+}
+
+// slugify lowercases s and collapses every run of characters outside [a-z0-9] into a
+// single dash, trimming dashes from both ends.
+func slugify(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			dash = false
+		} else if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// transformsEqual reports whether two transform pipelines are identical.
+func transformsEqual(a, b []Transform) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Kind != b[i].Kind ||
+			!ptrEqual(a[i].Length, b[i].Length) ||
+			!ptrEqual(a[i].Value, b[i].Value) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,226 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+)
+
+// How many trailing characters of a sensitive trigger value are shown.
+const sensitiveVisibleChars = 4
+
+// A triggerChange describes one trigger value that was added, changed or removed. Nested
+// values are diffed individually, so path may point inside the trigger named key, as in
+// `config.replicas` or `hosts[1]`.
+type triggerChange struct {
+	key      string
+	path     string
+	kind     p.DiffKind
+	old, new any
+}
+
+// triggerChanges returns every trigger value that differs between olds and news, ordered
+// by path.
+func triggerChanges(olds, news map[string]any) []triggerChange {
+	changes := []triggerChange{}
+	for _, key := range unionKeys(olds, news) {
+		oldValue, inOlds := olds[key]
+		newValue, inNews := news[key]
+		diffTriggerValue(key, key, oldValue, newValue, inOlds, inNews, &changes)
+	}
+	return changes
+}
+
+// diffTriggerValue appends the changes between two values at path to changes, descending
+// into objects and arrays found on both sides.
+func diffTriggerValue(key, path string, old, new any, inOlds, inNews bool, changes *[]triggerChange) {
+	switch {
+	case !inOlds:
+		*changes = append(*changes, triggerChange{key: key, path: path, kind: p.DiffKind("add"), new: new})
+		return
+	case !inNews:
+		*changes = append(*changes, triggerChange{key: key, path: path, kind: p.DiffKind("delete"), old: old})
+		return
+	case triggerValuesEqual(old, new):
+		return
+	}
+
+	if oldMap, ok := old.(map[string]any); ok {
+		if newMap, ok := new.(map[string]any); ok {
+			for _, k := range unionKeys(oldMap, newMap) {
+				o, inO := oldMap[k]
+				n, inN := newMap[k]
+				diffTriggerValue(key, path+"."+k, o, n, inO, inN, changes)
+			}
+			return
+		}
+	}
+	if oldList, ok := old.([]any); ok {
+		if newList, ok := new.([]any); ok {
+			for i := 0; i < len(oldList) || i < len(newList); i++ {
+				var o, n any
+				if i < len(oldList) {
+					o = oldList[i]
+				}
+				if i < len(newList) {
+					n = newList[i]
+				}
+				diffTriggerValue(key, fmt.Sprintf("%s[%d]", path, i), o, n,
+					i < len(oldList), i < len(newList), changes)
+			}
+			return
+		}
+	}
+	*changes = append(*changes, triggerChange{key: key, path: path, kind: p.DiffKind("update"), old: old, new: new})
+}
+
+// triggerValuesEqual compares two trigger values by their canonical JSON encoding, which
+// ignores object key order and how a number happens to be written.
+func triggerValuesEqual(a, b any) bool {
+	return bytes.Equal(canonicalJSON(a), canonicalJSON(b))
+}
+
+// canonicalJSON encodes v with sorted object keys and shortest number formatting.
+func canonicalJSON(v any) []byte {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return []byte(fmt.Sprintf("%#v", v))
+	}
+	return data
+}
+
+func unionKeys(a, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// secretTriggersKey holds the paths of the secret trigger values of a Diff in its context.
+type secretTriggersKey struct{}
+
+// withSecretTriggers records in ctx the paths of the trigger values that are secret in
+// olds or news, since typed inputs no longer carry secret markers.
+func withSecretTriggers(ctx p.Context, olds, news resource.PropertyMap) p.Context {
+	var paths []string
+	var walk func(path string, v resource.PropertyValue)
+	walk = func(path string, v resource.PropertyValue) {
+		switch {
+		case !v.ContainsSecrets():
+		case v.IsSecret(), v.IsOutput() && v.OutputValue().Secret:
+			paths = append(paths, path)
+		case v.IsOutput():
+			walk(path, v.OutputValue().Element)
+		case v.IsObject():
+			for k, e := range v.ObjectValue() {
+				walk(path+"."+string(k), e)
+			}
+		case v.IsArray():
+			for i, e := range v.ArrayValue() {
+				walk(fmt.Sprintf("%s[%d]", path, i), e)
+			}
+		}
+	}
+	for _, m := range []resource.PropertyMap{olds, news} {
+		triggers := m["triggers"]
+		if triggers.IsSecret() {
+			triggers = triggers.SecretValue().Element
+			for k := range triggers.ObjectValue() {
+				paths = append(paths, string(k))
+			}
+		}
+		if triggers.IsObject() {
+			for k, v := range triggers.ObjectValue() {
+				walk(string(k), v)
+			}
+		}
+	}
+	return p.CtxWithValue(ctx, secretTriggersKey{}, paths)
+}
+
+// secretTriggers returns the paths recorded by withSecretTriggers.
+func secretTriggers(ctx p.Context) []string {
+	paths, _ := ctx.Value(secretTriggersKey{}).([]string)
+	return paths
+}
+
+// isSecretTrigger reports whether the value at path is, holds or lies within one of the
+// secret trigger values at secret.
+func isSecretTrigger(path string, secret []string) bool {
+	within := func(inner, outer string) bool {
+		return inner == outer || strings.HasPrefix(inner, outer+".") || strings.HasPrefix(inner, outer+"[")
+	}
+	for _, s := range secret {
+		if within(path, s) || within(s, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// explainTriggerChanges describes each change on its own line, hiding the values at the
+// secret paths and masking the values of the triggers named in sensitive.
+func explainTriggerChanges(changes []triggerChange, sensitive, secret []string) string {
+	lines := make([]string, len(changes))
+	for i, c := range changes {
+		show := func(v any) string {
+			if isSecretTrigger(c.path, secret) {
+				return "[secret]"
+			}
+			for _, key := range sensitive {
+				if key == c.key {
+					return maskTriggerValue(v)
+				}
+			}
+			return string(canonicalJSON(v))
+		}
+		switch c.kind {
+		case p.DiffKind("add"):
+			lines[i] = fmt.Sprintf("triggers.%s added: %s", c.path, show(c.new))
+		case p.DiffKind("delete"):
+			lines[i] = fmt.Sprintf("triggers.%s removed: was %s", c.path, show(c.old))
+		default:
+			lines[i] = fmt.Sprintf("triggers.%s changed: %s -> %s", c.path, show(c.old), show(c.new))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// maskTriggerValue hides all but the last few characters of a sensitive value.
+func maskTriggerValue(v any) string {
+	s, ok := v.(string)
+	if !ok {
+		s = string(canonicalJSON(v))
+	}
+	r := []rune(s)
+	if len(r) <= sensitiveVisibleChars {
+		return "****"
+	}
+	return "****" + string(r[len(r)-sensitiveVisibleChars:])
+}
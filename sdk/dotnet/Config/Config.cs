@@ -0,0 +1,76 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Immutable;
+
+namespace Pulumi.StatefulString
+{
+    public static class Config
+    {
+        [global::System.Diagnostics.CodeAnalysis.SuppressMessage("Microsoft.Design", "IDE1006", Justification = 
+        "Double underscore prefix used to avoid conflicts with variable names.")]
+        private sealed class __Value<T>
+        {
+            private readonly Func<T> _getter;
+            private T _value = default!;
+            private bool _set;
+
+            public __Value(Func<T> getter)
+            {
+                _getter = getter;
+            }
+
+            public T Get() => _set ? _value : _getter();
+
+            public void Set(T value)
+            {
+                _value = value;
+                _set = true;
+            }
+        }
+
+        private static readonly global::Pulumi.Config __config = new global::Pulumi.Config("statefulString");
+
+        private static readonly __Value<string?> _archiveDir = new __Value<string?>(() => __config.Get("archiveDir"));
+        /// <summary>
+        /// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        /// </summary>
+        public static string? ArchiveDir
+        {
+            get => _archiveDir.Get();
+            set => _archiveDir.Set(value);
+        }
+
+        private static readonly __Value<string?> _deriveSeed = new __Value<string?>(() => __config.Get("deriveSeed"));
+        /// <summary>
+        /// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        /// </summary>
+        public static string? DeriveSeed
+        {
+            get => _deriveSeed.Get();
+            set => _deriveSeed.Set(value);
+        }
+
+        private static readonly __Value<string?> _rotationManifest = new __Value<string?>(() => __config.Get("rotationManifest"));
+        /// <summary>
+        /// The path of the JSON file rotation groups are read from.
+        /// </summary>
+        public static string? RotationManifest
+        {
+            get => _rotationManifest.Get();
+            set => _rotationManifest.Set(value);
+        }
+
+        private static readonly __Value<string?> _sharedStoreDir = new __Value<string?>(() => __config.Get("sharedStoreDir"));
+        /// <summary>
+        /// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+        /// </summary>
+        public static string? SharedStoreDir
+        {
+            get => _sharedStoreDir.Get();
+            set => _sharedStoreDir.Set(value);
+        }
+
+    }
+}
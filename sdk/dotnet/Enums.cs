@@ -0,0 +1,291 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.ComponentModel;
+using Pulumi;
+
+namespace Pulumi.StatefulString
+{
+    [EnumType]
+    public readonly struct ECDSACurve : IEquatable<ECDSACurve>
+    {
+        private readonly string _value;
+
+        private ECDSACurve(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// NIST P-256.
+        /// </summary>
+        public static ECDSACurve P256 { get; } = new ECDSACurve("P256");
+        /// <summary>
+        /// NIST P-384.
+        /// </summary>
+        public static ECDSACurve P384 { get; } = new ECDSACurve("P384");
+        /// <summary>
+        /// NIST P-521.
+        /// </summary>
+        public static ECDSACurve P521 { get; } = new ECDSACurve("P521");
+
+        public static bool operator ==(ECDSACurve left, ECDSACurve right) => left.Equals(right);
+        public static bool operator !=(ECDSACurve left, ECDSACurve right) => !left.Equals(right);
+
+        public static explicit operator string(ECDSACurve value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ECDSACurve other && Equals(other);
+        public bool Equals(ECDSACurve other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct ExpiryPolicy : IEquatable<ExpiryPolicy>
+    {
+        private readonly string _value;
+
+        private ExpiryPolicy(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Emit a warning on every diff. This is the default.
+        /// </summary>
+        public static ExpiryPolicy Warn { get; } = new ExpiryPolicy("warn");
+        /// <summary>
+        /// Fail the update until the string is rotated.
+        /// </summary>
+        public static ExpiryPolicy Fail { get; } = new ExpiryPolicy("fail");
+        /// <summary>
+        /// Pin the current string input as if a trigger changed.
+        /// </summary>
+        public static ExpiryPolicy Rotate { get; } = new ExpiryPolicy("rotate");
+
+        public static bool operator ==(ExpiryPolicy left, ExpiryPolicy right) => left.Equals(right);
+        public static bool operator !=(ExpiryPolicy left, ExpiryPolicy right) => !left.Equals(right);
+
+        public static explicit operator string(ExpiryPolicy value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is ExpiryPolicy other && Equals(other);
+        public bool Equals(ExpiryPolicy other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct GeneratorKind : IEquatable<GeneratorKind>
+    {
+        private readonly string _value;
+
+        private GeneratorKind(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Draw `length` characters uniformly at random from `charset`.
+        /// </summary>
+        public static GeneratorKind Random { get; } = new GeneratorKind("random");
+        /// <summary>
+        /// Compute `length` characters from `charset` with an HMAC-SHA256 of the triggers, keyed by the provider's `deriveSeed`. The same seed and triggers always derive the same value.
+        /// </summary>
+        public static GeneratorKind Derive { get; } = new GeneratorKind("derive");
+        /// <summary>
+        /// Join `words` words from an embedded wordlist with `separator`: adjectives followed by a noun, such as `brave-otter`.
+        /// </summary>
+        public static GeneratorKind Petname { get; } = new GeneratorKind("petname");
+
+        public static bool operator ==(GeneratorKind left, GeneratorKind right) => left.Equals(right);
+        public static bool operator !=(GeneratorKind left, GeneratorKind right) => !left.Equals(right);
+
+        public static explicit operator string(GeneratorKind value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is GeneratorKind other && Equals(other);
+        public bool Equals(GeneratorKind other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct KeyAlgorithm : IEquatable<KeyAlgorithm>
+    {
+        private readonly string _value;
+
+        private KeyAlgorithm(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// An Ed25519 key.
+        /// </summary>
+        public static KeyAlgorithm Ed25519 { get; } = new KeyAlgorithm("ed25519");
+        /// <summary>
+        /// An RSA key of `rsaBits` bits.
+        /// </summary>
+        public static KeyAlgorithm Rsa { get; } = new KeyAlgorithm("rsa");
+        /// <summary>
+        /// An ECDSA key on `ecdsaCurve`.
+        /// </summary>
+        public static KeyAlgorithm Ecdsa { get; } = new KeyAlgorithm("ecdsa");
+
+        public static bool operator ==(KeyAlgorithm left, KeyAlgorithm right) => left.Equals(right);
+        public static bool operator !=(KeyAlgorithm left, KeyAlgorithm right) => !left.Equals(right);
+
+        public static explicit operator string(KeyAlgorithm value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is KeyAlgorithm other && Equals(other);
+        public bool Equals(KeyAlgorithm other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct RotationMode : IEquatable<RotationMode>
+    {
+        private readonly string _value;
+
+        private RotationMode(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Pin the string whenever a trigger is added, removed or changed. This is the default.
+        /// </summary>
+        public static RotationMode RotateOnTriggerChange { get; } = new RotationMode("rotateOnTriggerChange");
+        /// <summary>
+        /// Pin the string whenever it or a trigger changes.
+        /// </summary>
+        public static RotationMode RotateOnStringChange { get; } = new RotationMode("rotateOnStringChange");
+        /// <summary>
+        /// Pin the string only when the forceRotate nonce changes.
+        /// </summary>
+        public static RotationMode @Explicit { get; } = new RotationMode("explicit");
+
+        public static bool operator ==(RotationMode left, RotationMode right) => left.Equals(right);
+        public static bool operator !=(RotationMode left, RotationMode right) => !left.Equals(right);
+
+        public static explicit operator string(RotationMode value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is RotationMode other && Equals(other);
+        public bool Equals(RotationMode other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct SourceKind : IEquatable<SourceKind>
+    {
+        private readonly string _value;
+
+        private SourceKind(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Read the environment variable `name` of the provider process.
+        /// </summary>
+        public static SourceKind Env { get; } = new SourceKind("env");
+        /// <summary>
+        /// Read the local file at `path`.
+        /// </summary>
+        public static SourceKind File { get; } = new SourceKind("file");
+        /// <summary>
+        /// Run `command` and read its standard output.
+        /// </summary>
+        public static SourceKind Command { get; } = new SourceKind("command");
+
+        public static bool operator ==(SourceKind left, SourceKind right) => left.Equals(right);
+        public static bool operator !=(SourceKind left, SourceKind right) => !left.Equals(right);
+
+        public static explicit operator string(SourceKind value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is SourceKind other && Equals(other);
+        public bool Equals(SourceKind other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+
+    [EnumType]
+    public readonly struct TransformKind : IEquatable<TransformKind>
+    {
+        private readonly string _value;
+
+        private TransformKind(string value)
+        {
+            _value = value ?? throw new ArgumentNullException(nameof(value));
+        }
+
+        /// <summary>
+        /// Lowercase the value.
+        /// </summary>
+        public static TransformKind Lower { get; } = new TransformKind("lower");
+        /// <summary>
+        /// Uppercase the value.
+        /// </summary>
+        public static TransformKind Upper { get; } = new TransformKind("upper");
+        /// <summary>
+        /// Keep at most `length` characters (63 by default).
+        /// </summary>
+        public static TransformKind Truncate { get; } = new TransformKind("truncate");
+        /// <summary>
+        /// Reduce the value to lowercase letters, digits and single dashes.
+        /// </summary>
+        public static TransformKind Slugify { get; } = new TransformKind("slugify");
+        /// <summary>
+        /// Prepend `value`.
+        /// </summary>
+        public static TransformKind Prefix { get; } = new TransformKind("prefix");
+        /// <summary>
+        /// Append `value`.
+        /// </summary>
+        public static TransformKind Suffix { get; } = new TransformKind("suffix");
+        /// <summary>
+        /// Standard base64 encode the value.
+        /// </summary>
+        public static TransformKind Base64 { get; } = new TransformKind("base64");
+
+        public static bool operator ==(TransformKind left, TransformKind right) => left.Equals(right);
+        public static bool operator !=(TransformKind left, TransformKind right) => !left.Equals(right);
+
+        public static explicit operator string(TransformKind value) => value._value;
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override bool Equals(object? obj) => obj is TransformKind other && Equals(other);
+        public bool Equals(TransformKind other) => string.Equals(_value, other._value, StringComparison.Ordinal);
+
+        [EditorBrowsable(EditorBrowsableState.Never)]
+        public override int GetHashCode() => _value?.GetHashCode() ?? 0;
+
+        public override string ToString() => _value;
+    }
+}
@@ -0,0 +1,71 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString
+{
+    public static class GetRotationGroupTrigger
+    {
+        /// <summary>
+        /// Computes the shared trigger value of a rotation group from the provider's rotation manifest, for use as a trigger on resources outside the group.
+        /// </summary>
+        public static Task<GetRotationGroupTriggerResult> InvokeAsync(GetRotationGroupTriggerArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetRotationGroupTriggerResult>("statefulString:index:getRotationGroupTrigger", args ?? new GetRotationGroupTriggerArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Computes the shared trigger value of a rotation group from the provider's rotation manifest, for use as a trigger on resources outside the group.
+        /// </summary>
+        public static Output<GetRotationGroupTriggerResult> Invoke(GetRotationGroupTriggerInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetRotationGroupTriggerResult>("statefulString:index:getRotationGroupTrigger", args ?? new GetRotationGroupTriggerInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetRotationGroupTriggerArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the group in the rotation manifest.
+        /// </summary>
+        [Input("group", required: true)]
+        public string Group { get; set; } = null!;
+
+        public GetRotationGroupTriggerArgs()
+        {
+        }
+        public static new GetRotationGroupTriggerArgs Empty => new GetRotationGroupTriggerArgs();
+    }
+
+    public sealed class GetRotationGroupTriggerInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the group in the rotation manifest.
+        /// </summary>
+        [Input("group", required: true)]
+        public Input<string> Group { get; set; } = null!;
+
+        public GetRotationGroupTriggerInvokeArgs()
+        {
+        }
+        public static new GetRotationGroupTriggerInvokeArgs Empty => new GetRotationGroupTriggerInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetRotationGroupTriggerResult
+    {
+        /// <summary>
+        /// The trigger value of the group, which changes whenever its manifest entry does.
+        /// </summary>
+        public readonly string Trigger;
+
+        [OutputConstructor]
+        private GetRotationGroupTriggerResult(string trigger)
+        {
+            Trigger = trigger;
+        }
+    }
+}
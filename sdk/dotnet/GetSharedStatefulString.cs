@@ -0,0 +1,86 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString
+{
+    public static class GetSharedStatefulString
+    {
+        /// <summary>
+        /// Reads a string published by a SharedStatefulString, possibly from another stack.
+        /// </summary>
+        public static Task<GetSharedStatefulStringResult> InvokeAsync(GetSharedStatefulStringArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.InvokeAsync<GetSharedStatefulStringResult>("statefulString:index:getSharedStatefulString", args ?? new GetSharedStatefulStringArgs(), options.WithDefaults());
+
+        /// <summary>
+        /// Reads a string published by a SharedStatefulString, possibly from another stack.
+        /// </summary>
+        public static Output<GetSharedStatefulStringResult> Invoke(GetSharedStatefulStringInvokeArgs args, InvokeOptions? options = null)
+            => global::Pulumi.Deployment.Instance.Invoke<GetSharedStatefulStringResult>("statefulString:index:getSharedStatefulString", args ?? new GetSharedStatefulStringInvokeArgs(), options.WithDefaults());
+    }
+
+
+    public sealed class GetSharedStatefulStringArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the record in the shared store.
+        /// </summary>
+        [Input("key", required: true)]
+        public string Key { get; set; } = null!;
+
+        public GetSharedStatefulStringArgs()
+        {
+        }
+        public static new GetSharedStatefulStringArgs Empty => new GetSharedStatefulStringArgs();
+    }
+
+    public sealed class GetSharedStatefulStringInvokeArgs : global::Pulumi.InvokeArgs
+    {
+        /// <summary>
+        /// The name of the record in the shared store.
+        /// </summary>
+        [Input("key", required: true)]
+        public Input<string> Key { get; set; } = null!;
+
+        public GetSharedStatefulStringInvokeArgs()
+        {
+        }
+        public static new GetSharedStatefulStringInvokeArgs Empty => new GetSharedStatefulStringInvokeArgs();
+    }
+
+
+    [OutputType]
+    public sealed class GetSharedStatefulStringResult
+    {
+        /// <summary>
+        /// The published string.
+        /// </summary>
+        public readonly string String;
+        /// <summary>
+        /// The triggers the string was last pinned with.
+        /// </summary>
+        public readonly ImmutableDictionary<string, object>? Triggers;
+        /// <summary>
+        /// The RFC 3339 time at which the record was last published.
+        /// </summary>
+        public readonly string UpdatedAt;
+
+        [OutputConstructor]
+        private GetSharedStatefulStringResult(
+            string @string,
+
+            ImmutableDictionary<string, object>? triggers,
+
+            string updatedAt)
+        {
+            String = @string;
+            Triggers = triggers;
+            UpdatedAt = updatedAt;
+        }
+    }
+}
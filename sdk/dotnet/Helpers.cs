@@ -0,0 +1,121 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is written by hand. The Makefile lays it over the generated SDK.
+
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Security.Cryptography;
+using System.Text.RegularExpressions;
+
+namespace Pulumi.StatefulString
+{
+    /// <summary>
+    /// Builders for the trigger patterns most programs need.
+    /// </summary>
+    public static class Triggers
+    {
+        /// <summary>
+        /// Returns triggers that change whenever the content of one of the files changes. Each file maps to the
+        /// SHA-256 of its content, so the content itself never reaches the state. The key is the base name of the
+        /// file with every character other than a letter, digit, <c>_</c> or <c>-</c> replaced by <c>_</c>, so that
+        /// it does not depend on where the program runs; two files with the same key are an error.
+        /// </summary>
+        public static InputMap<object> FromFiles(params string[] paths)
+        {
+            var triggers = new InputMap<object>();
+            var keys = new HashSet<string>();
+            foreach (var path in paths)
+            {
+                var key = FileTriggerKey(path);
+                if (!keys.Add(key))
+                {
+                    throw new ArgumentException($"{path}: another file already uses the trigger key \"{key}\"");
+                }
+                using var sha256 = SHA256.Create();
+                var hash = sha256.ComputeHash(File.ReadAllBytes(path));
+                triggers.Add(key, Convert.ToHexString(hash).ToLowerInvariant());
+            }
+            return triggers;
+        }
+
+        private static string FileTriggerKey(string path)
+        {
+            return Regex.Replace(Path.GetFileName(path), "[^A-Za-z0-9_-]", "_");
+        }
+
+        /// <summary>
+        /// Returns triggers that change whenever one of the outputs resolves to a new value.
+        /// </summary>
+        public static InputMap<object> FromOutputs(IDictionary<string, Input<object>> outputs)
+        {
+            var triggers = new InputMap<object>();
+            foreach (var (key, output) in outputs)
+            {
+                triggers.Add(key, output);
+            }
+            return triggers;
+        }
+
+        /// <summary>
+        /// Returns a trigger that changes whenever the image is rebuilt. A reference pinned by digest, such as
+        /// <c>repo:tag@sha256:...</c>, triggers on its digest alone; any other reference triggers on the whole
+        /// reference.
+        /// </summary>
+        public static InputMap<object> OnImageDigest(Input<string> image)
+        {
+            return new InputMap<object>
+            {
+                { "imageDigest", image.Apply(ImageDigest) },
+            };
+        }
+
+        private static object ImageDigest(string image)
+        {
+            var i = image.LastIndexOf('@');
+            return i >= 0 ? image.Substring(i + 1) : image;
+        }
+
+        /// <summary>
+        /// Combines several sets of triggers into one. Later sets win when a key appears more than once.
+        /// </summary>
+        public static InputMap<object> Merge(params InputMap<object>[] sets)
+        {
+            var triggers = new InputMap<object>();
+            foreach (var set in sets)
+            {
+                triggers = InputMap<object>.Merge(triggers, set);
+            }
+            return triggers;
+        }
+    }
+
+    public partial class StatefulString
+    {
+        /// <summary>
+        /// Shorthand for a StatefulString that pins <paramref name="value"/> until one of
+        /// <paramref name="triggers"/> changes.
+        /// </summary>
+        public static StatefulString Pinned(string name, Input<string> value, InputMap<object> triggers,
+            CustomResourceOptions? options = null)
+        {
+            return new StatefulString(name, new StatefulStringArgs
+            {
+                String = value,
+                Triggers = triggers,
+            }, options);
+        }
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class Argon2OptionsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+        /// </summary>
+        [Input("memory")]
+        public Input<int>? Memory { get; set; }
+
+        /// <summary>
+        /// The degree of parallelism, from 1 to 255. Defaults to 4.
+        /// </summary>
+        [Input("threads")]
+        public Input<int>? Threads { get; set; }
+
+        /// <summary>
+        /// The number of passes over the memory, from 1 to 10. Defaults to 1.
+        /// </summary>
+        [Input("time")]
+        public Input<int>? Time { get; set; }
+
+        public Argon2OptionsArgs()
+        {
+        }
+        public static new Argon2OptionsArgs Empty => new Argon2OptionsArgs();
+    }
+}
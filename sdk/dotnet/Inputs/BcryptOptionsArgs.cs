@@ -0,0 +1,26 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class BcryptOptionsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The bcrypt cost, from 4 to 16. Defaults to 10.
+        /// </summary>
+        [Input("cost")]
+        public Input<int>? Cost { get; set; }
+
+        public BcryptOptionsArgs()
+        {
+        }
+        public static new BcryptOptionsArgs Empty => new BcryptOptionsArgs();
+    }
+}
@@ -0,0 +1,32 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class BundleVariantArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The characters the variant is made of. Defaults to letters and digits.
+        /// </summary>
+        [Input("charset")]
+        public Input<string>? Charset { get; set; }
+
+        /// <summary>
+        /// How many characters the variant has. Defaults to 32.
+        /// </summary>
+        [Input("length")]
+        public Input<int>? Length { get; set; }
+
+        public BundleVariantArgs()
+        {
+        }
+        public static new BundleVariantArgs Empty => new BundleVariantArgs();
+    }
+}
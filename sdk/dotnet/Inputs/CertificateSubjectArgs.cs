@@ -0,0 +1,32 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class CertificateSubjectArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The common name (CN) of the subject.
+        /// </summary>
+        [Input("commonName", required: true)]
+        public Input<string> CommonName { get; set; } = null!;
+
+        /// <summary>
+        /// The organization (O) of the subject.
+        /// </summary>
+        [Input("organization")]
+        public Input<string>? Organization { get; set; }
+
+        public CertificateSubjectArgs()
+        {
+        }
+        public static new CertificateSubjectArgs Empty => new CertificateSubjectArgs();
+    }
+}
@@ -0,0 +1,62 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class GeneratorArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The characters a generated value is made of. Defaults to letters and digits.
+        /// </summary>
+        [Input("charset")]
+        public Input<string>? Charset { get; set; }
+
+        /// <summary>
+        /// How values are generated.
+        /// </summary>
+        [Input("kind", required: true)]
+        public Input<Pulumi.StatefulString.GeneratorKind> Kind { get; set; } = null!;
+
+        /// <summary>
+        /// How many characters a generated value has. Defaults to 32.
+        /// </summary>
+        [Input("length")]
+        public Input<int>? Length { get; set; }
+
+        /// <summary>
+        /// The most characters a petname may have, separators included.
+        /// </summary>
+        [Input("maxLength")]
+        public Input<int>? MaxLength { get; set; }
+
+        /// <summary>
+        /// Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+        /// </summary>
+        [Input("seed")]
+        public Input<string>? Seed { get; set; }
+
+        /// <summary>
+        /// What the words of a petname are joined with. Defaults to `-`.
+        /// </summary>
+        [Input("separator")]
+        public Input<string>? Separator { get; set; }
+
+        /// <summary>
+        /// How many words a petname has. Defaults to 2.
+        /// </summary>
+        [Input("words")]
+        public Input<int>? Words { get; set; }
+
+        public GeneratorArgs()
+        {
+        }
+        public static new GeneratorArgs Empty => new GeneratorArgs();
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class HashOptionsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// Compute `argon2Hash`, an argon2id hash.
+        /// </summary>
+        [Input("argon2")]
+        public Input<Inputs.Argon2OptionsArgs>? Argon2 { get; set; }
+
+        /// <summary>
+        /// Compute `bcryptHash`.
+        /// </summary>
+        [Input("bcrypt")]
+        public Input<Inputs.BcryptOptionsArgs>? Bcrypt { get; set; }
+
+        /// <summary>
+        /// Compute `scryptHash`.
+        /// </summary>
+        [Input("scrypt")]
+        public Input<Inputs.ScryptOptionsArgs>? Scrypt { get; set; }
+
+        public HashOptionsArgs()
+        {
+        }
+        public static new HashOptionsArgs Empty => new HashOptionsArgs();
+    }
+}
@@ -0,0 +1,32 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class PairFieldArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// How to generate the value to pin. A new value is only generated when the pair rotates.
+        /// </summary>
+        [Input("generator")]
+        public Input<Inputs.GeneratorArgs>? Generator { get; set; }
+
+        /// <summary>
+        /// The value to pin. Exactly one of `value` and `generator` is set.
+        /// </summary>
+        [Input("value")]
+        public Input<string>? Value { get; set; }
+
+        public PairFieldArgs()
+        {
+        }
+        public static new PairFieldArgs Empty => new PairFieldArgs();
+    }
+}
@@ -0,0 +1,38 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class ScryptOptionsArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+        /// </summary>
+        [Input("logN")]
+        public Input<int>? LogN { get; set; }
+
+        /// <summary>
+        /// The parallelization. Defaults to 1.
+        /// </summary>
+        [Input("p")]
+        public Input<int>? P { get; set; }
+
+        /// <summary>
+        /// The block size. Defaults to 8.
+        /// </summary>
+        [Input("r")]
+        public Input<int>? R { get; set; }
+
+        public ScryptOptionsArgs()
+        {
+        }
+        public static new ScryptOptionsArgs Empty => new ScryptOptionsArgs();
+    }
+}
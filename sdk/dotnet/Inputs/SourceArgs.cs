@@ -0,0 +1,50 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class SourceArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("command")]
+        private InputList<string>? _command;
+
+        /// <summary>
+        /// The program and arguments a `command` source runs.
+        /// </summary>
+        public InputList<string> Command
+        {
+            get => _command ?? (_command = new InputList<string>());
+            set => _command = value;
+        }
+
+        /// <summary>
+        /// The kind of source.
+        /// </summary>
+        [Input("kind", required: true)]
+        public Input<Pulumi.StatefulString.SourceKind> Kind { get; set; } = null!;
+
+        /// <summary>
+        /// The environment variable an `env` source reads.
+        /// </summary>
+        [Input("name")]
+        public Input<string>? Name { get; set; }
+
+        /// <summary>
+        /// The file a `file` source reads.
+        /// </summary>
+        [Input("path")]
+        public Input<string>? Path { get; set; }
+
+        public SourceArgs()
+        {
+        }
+        public static new SourceArgs Empty => new SourceArgs();
+    }
+}
@@ -0,0 +1,39 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Inputs
+{
+
+    public sealed class TransformArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The transform to apply.
+        /// </summary>
+        [Input("kind", required: true)]
+        public Input<Pulumi.StatefulString.TransformKind> Kind { get; set; } = null!;
+
+        /// <summary>
+        /// The length a `truncate` transform keeps.
+        /// </summary>
+        [Input("length")]
+        public Input<int>? Length { get; set; }
+
+        /// <summary>
+        /// The text a `prefix` or `suffix` transform adds.
+        /// </summary>
+        [Input("value")]
+        public Input<string>? Value { get; set; }
+
+        public TransformArgs()
+        {
+            Length = 63;
+        }
+        public static new TransformArgs Empty => new TransformArgs();
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class Argon2Options
+    {
+        /// <summary>
+        /// The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+        /// </summary>
+        public readonly int? Memory;
+        /// <summary>
+        /// The degree of parallelism, from 1 to 255. Defaults to 4.
+        /// </summary>
+        public readonly int? Threads;
+        /// <summary>
+        /// The number of passes over the memory, from 1 to 10. Defaults to 1.
+        /// </summary>
+        public readonly int? Time;
+
+        [OutputConstructor]
+        private Argon2Options(
+            int? memory,
+
+            int? threads,
+
+            int? time)
+        {
+            Memory = memory;
+            Threads = threads;
+            Time = time;
+        }
+    }
+}
@@ -0,0 +1,27 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class BcryptOptions
+    {
+        /// <summary>
+        /// The bcrypt cost, from 4 to 16. Defaults to 10.
+        /// </summary>
+        public readonly int? Cost;
+
+        [OutputConstructor]
+        private BcryptOptions(int? cost)
+        {
+            Cost = cost;
+        }
+    }
+}
@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class CertificateSubject
+    {
+        /// <summary>
+        /// The common name (CN) of the subject.
+        /// </summary>
+        public readonly string CommonName;
+        /// <summary>
+        /// The organization (O) of the subject.
+        /// </summary>
+        public readonly string? Organization;
+
+        [OutputConstructor]
+        private CertificateSubject(
+            string commonName,
+
+            string? organization)
+        {
+            CommonName = commonName;
+            Organization = organization;
+        }
+    }
+}
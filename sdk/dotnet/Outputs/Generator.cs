@@ -0,0 +1,70 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class Generator
+    {
+        /// <summary>
+        /// The characters a generated value is made of. Defaults to letters and digits.
+        /// </summary>
+        public readonly string? Charset;
+        /// <summary>
+        /// How values are generated.
+        /// </summary>
+        public readonly Pulumi.StatefulString.GeneratorKind Kind;
+        /// <summary>
+        /// How many characters a generated value has. Defaults to 32.
+        /// </summary>
+        public readonly int? Length;
+        /// <summary>
+        /// The most characters a petname may have, separators included.
+        /// </summary>
+        public readonly int? MaxLength;
+        /// <summary>
+        /// Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+        /// </summary>
+        public readonly string? Seed;
+        /// <summary>
+        /// What the words of a petname are joined with. Defaults to `-`.
+        /// </summary>
+        public readonly string? Separator;
+        /// <summary>
+        /// How many words a petname has. Defaults to 2.
+        /// </summary>
+        public readonly int? Words;
+
+        [OutputConstructor]
+        private Generator(
+            string? charset,
+
+            Pulumi.StatefulString.GeneratorKind kind,
+
+            int? length,
+
+            int? maxLength,
+
+            string? seed,
+
+            string? separator,
+
+            int? words)
+        {
+            Charset = charset;
+            Kind = kind;
+            Length = length;
+            MaxLength = maxLength;
+            Seed = seed;
+            Separator = separator;
+            Words = words;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class HashOptions
+    {
+        /// <summary>
+        /// Compute `argon2Hash`, an argon2id hash.
+        /// </summary>
+        public readonly Outputs.Argon2Options? Argon2;
+        /// <summary>
+        /// Compute `bcryptHash`.
+        /// </summary>
+        public readonly Outputs.BcryptOptions? Bcrypt;
+        /// <summary>
+        /// Compute `scryptHash`.
+        /// </summary>
+        public readonly Outputs.ScryptOptions? Scrypt;
+
+        [OutputConstructor]
+        private HashOptions(
+            Outputs.Argon2Options? argon2,
+
+            Outputs.BcryptOptions? bcrypt,
+
+            Outputs.ScryptOptions? scrypt)
+        {
+            Argon2 = argon2;
+            Bcrypt = bcrypt;
+            Scrypt = scrypt;
+        }
+    }
+}
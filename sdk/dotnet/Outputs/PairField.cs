@@ -0,0 +1,35 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class PairField
+    {
+        /// <summary>
+        /// How to generate the value to pin. A new value is only generated when the pair rotates.
+        /// </summary>
+        public readonly Outputs.Generator? Generator;
+        /// <summary>
+        /// The value to pin. Exactly one of `value` and `generator` is set.
+        /// </summary>
+        public readonly string? Value;
+
+        [OutputConstructor]
+        private PairField(
+            Outputs.Generator? generator,
+
+            string? value)
+        {
+            Generator = generator;
+            Value = value;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class ScryptOptions
+    {
+        /// <summary>
+        /// The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+        /// </summary>
+        public readonly int? LogN;
+        /// <summary>
+        /// The parallelization. Defaults to 1.
+        /// </summary>
+        public readonly int? P;
+        /// <summary>
+        /// The block size. Defaults to 8.
+        /// </summary>
+        public readonly int? R;
+
+        [OutputConstructor]
+        private ScryptOptions(
+            int? logN,
+
+            int? p,
+
+            int? r)
+        {
+            LogN = logN;
+            P = p;
+            R = r;
+        }
+    }
+}
@@ -0,0 +1,49 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class Source
+    {
+        /// <summary>
+        /// The program and arguments a `command` source runs.
+        /// </summary>
+        public readonly ImmutableArray<string> Command;
+        /// <summary>
+        /// The kind of source.
+        /// </summary>
+        public readonly Pulumi.StatefulString.SourceKind Kind;
+        /// <summary>
+        /// The environment variable an `env` source reads.
+        /// </summary>
+        public readonly string? Name;
+        /// <summary>
+        /// The file a `file` source reads.
+        /// </summary>
+        public readonly string? Path;
+
+        [OutputConstructor]
+        private Source(
+            ImmutableArray<string> command,
+
+            Pulumi.StatefulString.SourceKind kind,
+
+            string? name,
+
+            string? path)
+        {
+            Command = command;
+            Kind = kind;
+            Name = name;
+            Path = path;
+        }
+    }
+}
@@ -0,0 +1,42 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString.Outputs
+{
+
+    [OutputType]
+    public sealed class Transform
+    {
+        /// <summary>
+        /// The transform to apply.
+        /// </summary>
+        public readonly Pulumi.StatefulString.TransformKind Kind;
+        /// <summary>
+        /// The length a `truncate` transform keeps.
+        /// </summary>
+        public readonly int? Length;
+        /// <summary>
+        /// The text a `prefix` or `suffix` transform adds.
+        /// </summary>
+        public readonly string? Value;
+
+        [OutputConstructor]
+        private Transform(
+            Pulumi.StatefulString.TransformKind kind,
+
+            int? length,
+
+            string? value)
+        {
+            Kind = kind;
+            Length = length;
+            Value = value;
+        }
+    }
+}
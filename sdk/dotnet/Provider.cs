@@ -12,6 +12,31 @@ namespace Pulumi.StatefulString
     [StatefulStringResourceType("pulumi:providers:statefulString")]
     public partial class Provider : global::Pulumi.ProviderResource
     {
+        /// <summary>
+        /// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        /// </summary>
+        [Output("archiveDir")]
+        public Output<string?> ArchiveDir { get; private set; } = null!;
+
+        /// <summary>
+        /// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        /// </summary>
+        [Output("deriveSeed")]
+        public Output<string?> DeriveSeed { get; private set; } = null!;
+
+        /// <summary>
+        /// The path of the JSON file rotation groups are read from.
+        /// </summary>
+        [Output("rotationManifest")]
+        public Output<string?> RotationManifest { get; private set; } = null!;
+
+        /// <summary>
+        /// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+        /// </summary>
+        [Output("sharedStoreDir")]
+        public Output<string?> SharedStoreDir { get; private set; } = null!;
+
+
         /// <summary>
         /// Create a Provider resource with the given unique name, arguments, and options.
         /// </summary>
@@ -29,6 +54,10 @@ namespace Pulumi.StatefulString
             var defaultOptions = new CustomResourceOptions
             {
                 Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "deriveSeed",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -39,6 +68,40 @@ namespace Pulumi.StatefulString
 
     public sealed class ProviderArgs : global::Pulumi.ResourceArgs
     {
+        /// <summary>
+        /// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        /// </summary>
+        [Input("archiveDir")]
+        public Input<string>? ArchiveDir { get; set; }
+
+        [Input("deriveSeed")]
+        private Input<string>? _deriveSeed;
+
+        /// <summary>
+        /// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        /// </summary>
+        public Input<string>? DeriveSeed
+        {
+            get => _deriveSeed;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _deriveSeed = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        /// <summary>
+        /// The path of the JSON file rotation groups are read from.
+        /// </summary>
+        [Input("rotationManifest")]
+        public Input<string>? RotationManifest { get; set; }
+
+        /// <summary>
+        /// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+        /// </summary>
+        [Input("sharedStoreDir")]
+        public Input<string>? SharedStoreDir { get; set; }
+
         public ProviderArgs()
         {
         }
@@ -0,0 +1,150 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString
+{
+    /// <summary>
+    /// A generated value pinned by a StatefulString, together with the forms programs derive from it. Every output rotates with the pinned value.
+    /// </summary>
+    [StatefulStringResourceType("statefulString:index:SecretBundle")]
+    public partial class SecretBundle : global::Pulumi.ComponentResource
+    {
+        /// <summary>
+        /// The argon2id hash of the pinned value, when `hashes.argon2` is set.
+        /// </summary>
+        [Output("argon2Hash")]
+        public Output<string?> Argon2Hash { get; private set; } = null!;
+
+        /// <summary>
+        /// The bcrypt hash of the pinned value, when `hashes.bcrypt` is set.
+        /// </summary>
+        [Output("bcryptHash")]
+        public Output<string?> BcryptHash { get; private set; } = null!;
+
+        /// <summary>
+        /// The RFC 3339 time at which the pinned value expires.
+        /// </summary>
+        [Output("expiresAt")]
+        public Output<string?> ExpiresAt { get; private set; } = null!;
+
+        /// <summary>
+        /// How many values have been pinned, starting from 1.
+        /// </summary>
+        [Output("revision")]
+        public Output<int> Revision { get; private set; } = null!;
+
+        /// <summary>
+        /// The scrypt hash of the pinned value, when `hashes.scrypt` is set.
+        /// </summary>
+        [Output("scryptHash")]
+        public Output<string?> ScryptHash { get; private set; } = null!;
+
+        /// <summary>
+        /// The hex SHA-256 digest of the pinned value.
+        /// </summary>
+        [Output("sha256")]
+        public Output<string> Sha256 { get; private set; } = null!;
+
+        /// <summary>
+        /// The pinned value.
+        /// </summary>
+        [Output("value")]
+        public Output<string> Value { get; private set; } = null!;
+
+        /// <summary>
+        /// The variants of the pinned value, by name.
+        /// </summary>
+        [Output("variants")]
+        public Output<ImmutableDictionary<string, string>> Variants { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SecretBundle resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SecretBundle(string name, SecretBundleArgs? args = null, ComponentResourceOptions? options = null)
+            : base("statefulString:index:SecretBundle", name, args ?? new SecretBundleArgs(), MakeResourceOptions(options, ""), remote: true)
+        {
+        }
+
+        private static ComponentResourceOptions MakeResourceOptions(ComponentResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new ComponentResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "argon2Hash",
+                    "bcryptHash",
+                    "scryptHash",
+                    "sha256",
+                    "value",
+                    "variants",
+                },
+            };
+            var merged = ComponentResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+    }
+
+    public sealed class SecretBundleArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// How long a pinned value lives, such as `720h`.
+        /// </summary>
+        [Input("expiresAfter")]
+        public Input<string>? ExpiresAfter { get; set; }
+
+        /// <summary>
+        /// How to generate the pinned value. Defaults to 32 random letters and digits.
+        /// </summary>
+        [Input("generator")]
+        public Input<Inputs.GeneratorArgs>? Generator { get; set; }
+
+        /// <summary>
+        /// The salted hashes of the pinned value to compute.
+        /// </summary>
+        [Input("hashes")]
+        public Input<Inputs.HashOptionsArgs>? Hashes { get; set; }
+
+        [Input("triggers")]
+        private InputMap<object>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values that rotate the value, and everything derived from it, whenever any of them changes.
+        /// </summary>
+        public InputMap<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<object>());
+            set => _triggers = value;
+        }
+
+        [Input("variants")]
+        private InputMap<Inputs.BundleVariantArgs>? _variants;
+
+        /// <summary>
+        /// Other forms of the pinned value to derive, by name. A variant only changes when the pinned value does.
+        /// </summary>
+        public InputMap<Inputs.BundleVariantArgs> Variants
+        {
+            get => _variants ?? (_variants = new InputMap<Inputs.BundleVariantArgs>());
+            set => _variants = value;
+        }
+
+        public SecretBundleArgs()
+        {
+        }
+        public static new SecretBundleArgs Empty => new SecretBundleArgs();
+    }
+}
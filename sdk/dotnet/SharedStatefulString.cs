@@ -0,0 +1,116 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString
+{
+    /// <summary>
+    /// A string that stays pinned until one of its triggers changes, published with its triggers to the provider's shared store so that other stacks can read it with `getSharedStatefulString`.
+    /// </summary>
+    [StatefulStringResourceType("statefulString:index:SharedStatefulString")]
+    public partial class SharedStatefulString : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The name of the record in the shared store. Changing it replaces the resource.
+        /// </summary>
+        [Output("key")]
+        public Output<string> Key { get; private set; } = null!;
+
+        /// <summary>
+        /// Identifies this resource as the owner of the record in the shared store.
+        /// </summary>
+        [Output("ownerId")]
+        public Output<string> OwnerId { get; private set; } = null!;
+
+        /// <summary>
+        /// The string to pin. Changes are only picked up when a trigger changes.
+        /// </summary>
+        [Output("string")]
+        public Output<string> String { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, object>?> Triggers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a SharedStatefulString resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public SharedStatefulString(string name, SharedStatefulStringArgs args, CustomResourceOptions? options = null)
+            : base("statefulString:index:SharedStatefulString", name, args ?? new SharedStatefulStringArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private SharedStatefulString(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("statefulString:index:SharedStatefulString", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing SharedStatefulString resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static SharedStatefulString Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new SharedStatefulString(name, id, options);
+        }
+    }
+
+    public sealed class SharedStatefulStringArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The name of the record in the shared store. Changing it replaces the resource.
+        /// </summary>
+        [Input("key", required: true)]
+        public Input<string> Key { get; set; } = null!;
+
+        /// <summary>
+        /// The string to pin. Changes are only picked up when a trigger changes.
+        /// </summary>
+        [Input("string", required: true)]
+        public Input<string> String { get; set; } = null!;
+
+        [Input("triggers")]
+        private InputMap<object>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+        /// </summary>
+        public InputMap<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<object>());
+            set => _triggers = value;
+        }
+
+        public SharedStatefulStringArgs()
+        {
+        }
+        public static new SharedStatefulStringArgs Empty => new SharedStatefulStringArgs();
+    }
+}
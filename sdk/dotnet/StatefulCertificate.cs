@@ -0,0 +1,275 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString
+{
+    /// <summary>
+    /// A self-signed or locally-CA-signed certificate that stays pinned until its contents or triggers change, or until it is within `renewBefore` of expiring.
+    /// </summary>
+    [StatefulStringResourceType("statefulString:index:StatefulCertificate")]
+    public partial class StatefulCertificate : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+        /// </summary>
+        [Output("caCertPem")]
+        public Output<string?> CaCertPem { get; private set; } = null!;
+
+        /// <summary>
+        /// The PEM private key of the CA that signs the certificate.
+        /// </summary>
+        [Output("caPrivateKeyPem")]
+        public Output<string?> CaPrivateKeyPem { get; private set; } = null!;
+
+        /// <summary>
+        /// The certificate in PEM format.
+        /// </summary>
+        [Output("certPem")]
+        public Output<string> CertPem { get; private set; } = null!;
+
+        /// <summary>
+        /// The DNS names the certificate is valid for.
+        /// </summary>
+        [Output("dnsNames")]
+        public Output<ImmutableArray<string>> DnsNames { get; private set; } = null!;
+
+        /// <summary>
+        /// The curve of an ECDSA key. Defaults to `P256`.
+        /// </summary>
+        [Output("ecdsaCurve")]
+        public Output<Pulumi.StatefulString.ECDSACurve?> EcdsaCurve { get; private set; } = null!;
+
+        /// <summary>
+        /// The hex SHA-256 digest of the DER certificate.
+        /// </summary>
+        [Output("fingerprintSha256")]
+        public Output<string> FingerprintSha256 { get; private set; } = null!;
+
+        /// <summary>
+        /// The IP addresses the certificate is valid for.
+        /// </summary>
+        [Output("ipAddresses")]
+        public Output<ImmutableArray<string>> IpAddresses { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the certificate can sign other certificates, for use as a local CA.
+        /// </summary>
+        [Output("isCa")]
+        public Output<bool?> IsCa { get; private set; } = null!;
+
+        /// <summary>
+        /// The kind of key to generate. Defaults to `ecdsa`.
+        /// </summary>
+        [Output("keyAlgorithm")]
+        public Output<Pulumi.StatefulString.KeyAlgorithm?> KeyAlgorithm { get; private set; } = null!;
+
+        /// <summary>
+        /// The RFC 3339 time at which the certificate expires.
+        /// </summary>
+        [Output("notAfter")]
+        public Output<string> NotAfter { get; private set; } = null!;
+
+        /// <summary>
+        /// The RFC 3339 time from which the certificate is valid.
+        /// </summary>
+        [Output("notBefore")]
+        public Output<string> NotBefore { get; private set; } = null!;
+
+        /// <summary>
+        /// The private key of the certificate in PKCS #8 PEM format.
+        /// </summary>
+        [Output("privateKeyPem")]
+        public Output<string> PrivateKeyPem { get; private set; } = null!;
+
+        /// <summary>
+        /// How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+        /// </summary>
+        [Output("renewBefore")]
+        public Output<string?> RenewBefore { get; private set; } = null!;
+
+        /// <summary>
+        /// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        /// </summary>
+        [Output("rsaBits")]
+        public Output<int?> RsaBits { get; private set; } = null!;
+
+        /// <summary>
+        /// The subject of the certificate.
+        /// </summary>
+        [Output("subject")]
+        public Output<Outputs.CertificateSubject> Subject { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, object>?> Triggers { get; private set; } = null!;
+
+        /// <summary>
+        /// How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+        /// </summary>
+        [Output("validFor")]
+        public Output<string?> ValidFor { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a StatefulCertificate resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public StatefulCertificate(string name, StatefulCertificateArgs args, CustomResourceOptions? options = null)
+            : base("statefulString:index:StatefulCertificate", name, args ?? new StatefulCertificateArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private StatefulCertificate(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("statefulString:index:StatefulCertificate", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "caPrivateKeyPem",
+                    "privateKeyPem",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing StatefulCertificate resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static StatefulCertificate Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new StatefulCertificate(name, id, options);
+        }
+    }
+
+    public sealed class StatefulCertificateArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+        /// </summary>
+        [Input("caCertPem")]
+        public Input<string>? CaCertPem { get; set; }
+
+        [Input("caPrivateKeyPem")]
+        private Input<string>? _caPrivateKeyPem;
+
+        /// <summary>
+        /// The PEM private key of the CA that signs the certificate.
+        /// </summary>
+        public Input<string>? CaPrivateKeyPem
+        {
+            get => _caPrivateKeyPem;
+            set
+            {
+                var emptySecret = Output.CreateSecret(0);
+                _caPrivateKeyPem = Output.Tuple<Input<string>?, int>(value, emptySecret).Apply(t => t.Item1);
+            }
+        }
+
+        [Input("dnsNames")]
+        private InputList<string>? _dnsNames;
+
+        /// <summary>
+        /// The DNS names the certificate is valid for.
+        /// </summary>
+        public InputList<string> DnsNames
+        {
+            get => _dnsNames ?? (_dnsNames = new InputList<string>());
+            set => _dnsNames = value;
+        }
+
+        /// <summary>
+        /// The curve of an ECDSA key. Defaults to `P256`.
+        /// </summary>
+        [Input("ecdsaCurve")]
+        public Input<Pulumi.StatefulString.ECDSACurve>? EcdsaCurve { get; set; }
+
+        [Input("ipAddresses")]
+        private InputList<string>? _ipAddresses;
+
+        /// <summary>
+        /// The IP addresses the certificate is valid for.
+        /// </summary>
+        public InputList<string> IpAddresses
+        {
+            get => _ipAddresses ?? (_ipAddresses = new InputList<string>());
+            set => _ipAddresses = value;
+        }
+
+        /// <summary>
+        /// Whether the certificate can sign other certificates, for use as a local CA.
+        /// </summary>
+        [Input("isCa")]
+        public Input<bool>? IsCa { get; set; }
+
+        /// <summary>
+        /// The kind of key to generate. Defaults to `ecdsa`.
+        /// </summary>
+        [Input("keyAlgorithm")]
+        public Input<Pulumi.StatefulString.KeyAlgorithm>? KeyAlgorithm { get; set; }
+
+        /// <summary>
+        /// How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+        /// </summary>
+        [Input("renewBefore")]
+        public Input<string>? RenewBefore { get; set; }
+
+        /// <summary>
+        /// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        /// </summary>
+        [Input("rsaBits")]
+        public Input<int>? RsaBits { get; set; }
+
+        /// <summary>
+        /// The subject of the certificate.
+        /// </summary>
+        [Input("subject", required: true)]
+        public Input<Inputs.CertificateSubjectArgs> Subject { get; set; } = null!;
+
+        [Input("triggers")]
+        private InputMap<object>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+        /// </summary>
+        public InputMap<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<object>());
+            set => _triggers = value;
+        }
+
+        /// <summary>
+        /// How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+        /// </summary>
+        [Input("validFor")]
+        public Input<string>? ValidFor { get; set; }
+
+        public StatefulCertificateArgs()
+        {
+        }
+        public static new StatefulCertificateArgs Empty => new StatefulCertificateArgs();
+    }
+}
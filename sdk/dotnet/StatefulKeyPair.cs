@@ -0,0 +1,157 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString
+{
+    /// <summary>
+    /// A generated key pair that stays pinned until one of its triggers changes. Changes to the key settings are only applied by the next rotation.
+    /// </summary>
+    [StatefulStringResourceType("statefulString:index:StatefulKeyPair")]
+    public partial class StatefulKeyPair : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The kind of key to generate.
+        /// </summary>
+        [Output("algorithm")]
+        public Output<Pulumi.StatefulString.KeyAlgorithm> Algorithm { get; private set; } = null!;
+
+        /// <summary>
+        /// The curve of an ECDSA key. Defaults to `P256`.
+        /// </summary>
+        [Output("ecdsaCurve")]
+        public Output<Pulumi.StatefulString.ECDSACurve?> EcdsaCurve { get; private set; } = null!;
+
+        /// <summary>
+        /// The OpenSSH SHA256 fingerprint of the public key.
+        /// </summary>
+        [Output("fingerprintSha256")]
+        public Output<string> FingerprintSha256 { get; private set; } = null!;
+
+        /// <summary>
+        /// The private key in OpenSSH format.
+        /// </summary>
+        [Output("privateKeyOpenssh")]
+        public Output<string> PrivateKeyOpenssh { get; private set; } = null!;
+
+        /// <summary>
+        /// The private key in PKCS #8 PEM format.
+        /// </summary>
+        [Output("privateKeyPem")]
+        public Output<string> PrivateKeyPem { get; private set; } = null!;
+
+        /// <summary>
+        /// The public key in OpenSSH authorized_keys format.
+        /// </summary>
+        [Output("publicKeyOpenssh")]
+        public Output<string> PublicKeyOpenssh { get; private set; } = null!;
+
+        /// <summary>
+        /// The public key in PKIX PEM format.
+        /// </summary>
+        [Output("publicKeyPem")]
+        public Output<string> PublicKeyPem { get; private set; } = null!;
+
+        /// <summary>
+        /// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        /// </summary>
+        [Output("rsaBits")]
+        public Output<int?> RsaBits { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, object>?> Triggers { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a StatefulKeyPair resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public StatefulKeyPair(string name, StatefulKeyPairArgs args, CustomResourceOptions? options = null)
+            : base("statefulString:index:StatefulKeyPair", name, args ?? new StatefulKeyPairArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private StatefulKeyPair(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("statefulString:index:StatefulKeyPair", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "privateKeyOpenssh",
+                    "privateKeyPem",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing StatefulKeyPair resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static StatefulKeyPair Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new StatefulKeyPair(name, id, options);
+        }
+    }
+
+    public sealed class StatefulKeyPairArgs : global::Pulumi.ResourceArgs
+    {
+        /// <summary>
+        /// The kind of key to generate.
+        /// </summary>
+        [Input("algorithm", required: true)]
+        public Input<Pulumi.StatefulString.KeyAlgorithm> Algorithm { get; set; } = null!;
+
+        /// <summary>
+        /// The curve of an ECDSA key. Defaults to `P256`.
+        /// </summary>
+        [Input("ecdsaCurve")]
+        public Input<Pulumi.StatefulString.ECDSACurve>? EcdsaCurve { get; set; }
+
+        /// <summary>
+        /// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        /// </summary>
+        [Input("rsaBits")]
+        public Input<int>? RsaBits { get; set; }
+
+        [Input("triggers")]
+        private InputMap<object>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+        /// </summary>
+        public InputMap<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<object>());
+            set => _triggers = value;
+        }
+
+        public StatefulKeyPairArgs()
+        {
+        }
+        public static new StatefulKeyPairArgs Empty => new StatefulKeyPairArgs();
+    }
+}
@@ -9,14 +9,269 @@ using Pulumi.Serialization;
 
 namespace Pulumi.StatefulString
 {
+    /// <summary>
+    /// A string that stays pinned to its first value until one of its triggers changes, at which point the latest `string` input is pinned instead.
+    /// </summary>
     [StatefulStringResourceType("statefulString:index:StatefulString")]
     public partial class StatefulString : global::Pulumi.CustomResource
     {
+        /// <summary>
+        /// The only values the string may take before it is pinned.
+        /// </summary>
+        [Output("allowedValues")]
+        public Output<ImmutableArray<string>> AllowedValues { get; private set; } = null!;
+
+        /// <summary>
+        /// The argon2id hash of the pinned string in PHC format, when `hashes.argon2` is set.
+        /// </summary>
+        [Output("argon2Hash")]
+        public Output<string?> Argon2Hash { get; private set; } = null!;
+
+        /// <summary>
+        /// The bcrypt hash of the pinned string, when `hashes.bcrypt` is set.
+        /// </summary>
+        [Output("bcryptHash")]
+        public Output<string?> BcryptHash { get; private set; } = null!;
+
+        /// <summary>
+        /// The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+        /// </summary>
+        [Output("charset")]
+        public Output<string?> Charset { get; private set; } = null!;
+
+        /// <summary>
+        /// The pinned string.
+        /// </summary>
+        [Output("current")]
+        public Output<string?> Current { get; private set; } = null!;
+
+        /// <summary>
+        /// The value of the source as of the last refresh or rotation.
+        /// </summary>
+        [Output("currentSourceValue")]
+        public Output<string?> CurrentSourceValue { get; private set; } = null!;
+
+        /// <summary>
+        /// The latest `string` input, even when it has not been pinned.
+        /// </summary>
+        [Output("desiredString")]
+        public Output<string?> DesiredString { get; private set; } = null!;
+
+        /// <summary>
+        /// How long a pinned string lives, such as `720h`.
+        /// </summary>
+        [Output("expiresAfter")]
+        public Output<string?> ExpiresAfter { get; private set; } = null!;
+
+        /// <summary>
+        /// The RFC 3339 time at which the pinned string expires.
+        /// </summary>
+        [Output("expiresAt")]
+        public Output<string?> ExpiresAt { get; private set; } = null!;
+
+        /// <summary>
+        /// A nonce: any change to it pins the string regardless of triggers.
+        /// </summary>
+        [Output("forceRotate")]
+        public Output<string?> ForceRotate { get; private set; } = null!;
+
+        /// <summary>
+        /// How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+        /// </summary>
+        [Output("generator")]
+        public Output<Outputs.Generator?> Generator { get; private set; } = null!;
+
+        /// <summary>
+        /// The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+        /// </summary>
+        [Output("hashes")]
+        public Output<Outputs.HashOptions?> Hashes { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the latest `string` input differs from the pinned string.
+        /// </summary>
+        [Output("isStale")]
+        public Output<bool?> IsStale { get; private set; } = null!;
+
+        /// <summary>
+        /// A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+        /// </summary>
+        [Output("jsonSchema")]
+        public Output<string?> JsonSchema { get; private set; } = null!;
+
+        /// <summary>
+        /// The most characters the string may have before it is pinned.
+        /// </summary>
+        [Output("maxLength")]
+        public Output<int?> MaxLength { get; private set; } = null!;
+
+        /// <summary>
+        /// The fewest characters the string may have before it is pinned.
+        /// </summary>
+        [Output("minLength")]
+        public Output<int?> MinLength { get; private set; } = null!;
+
+        /// <summary>
+        /// What happens once the pinned string has expired. Defaults to `warn`.
+        /// </summary>
+        [Output("onExpiry")]
+        public Output<Pulumi.StatefulString.ExpiryPolicy?> OnExpiry { get; private set; } = null!;
+
+        /// <summary>
+        /// The pinned string decoded as JSON. Only set when `jsonSchema` is given.
+        /// </summary>
+        [Output("parsed")]
+        public Output<ImmutableDictionary<string, object>?> Parsed { get; private set; } = null!;
+
+        /// <summary>
+        /// A regular expression the string must match before it is pinned.
+        /// </summary>
+        [Output("pattern")]
+        public Output<string?> Pattern { get; private set; } = null!;
+
+        /// <summary>
+        /// The string staged by a rotation and waiting to be promoted.
+        /// </summary>
+        [Output("pending")]
+        public Output<string?> Pending { get; private set; } = null!;
+
+        /// <summary>
+        /// How many updates have happened since `pending` was staged.
+        /// </summary>
+        [Output("pendingUpdates")]
+        public Output<int?> PendingUpdates { get; private set; } = null!;
+
+        /// <summary>
+        /// The string replaced by the last rotation, until `previousRetainedUntil`.
+        /// </summary>
+        [Output("previous")]
+        public Output<string?> Previous { get; private set; } = null!;
+
+        /// <summary>
+        /// The RFC 3339 time until which `previous` is kept.
+        /// </summary>
+        [Output("previousRetainedUntil")]
+        public Output<string?> PreviousRetainedUntil { get; private set; } = null!;
+
+        /// <summary>
+        /// How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+        /// </summary>
+        [Output("previousRetention")]
+        public Output<string?> PreviousRetention { get; private set; } = null!;
+
+        /// <summary>
+        /// A nonce: any change to it pins the pending string.
+        /// </summary>
+        [Output("promote")]
+        public Output<string?> Promote { get; private set; } = null!;
+
+        /// <summary>
+        /// Pin the pending string automatically on this many updates after it was staged.
+        /// </summary>
+        [Output("promoteAfterUpdates")]
+        public Output<int?> PromoteAfterUpdates { get; private set; } = null!;
+
+        /// <summary>
+        /// The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+        /// </summary>
+        [Output("restoreFrom")]
+        public Output<string?> RestoreFrom { get; private set; } = null!;
+
+        /// <summary>
+        /// The pinned string after `transforms` have been applied.
+        /// </summary>
+        [Output("result")]
+        public Output<string?> Result { get; private set; } = null!;
+
+        /// <summary>
+        /// Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+        /// </summary>
+        [Output("retainOnDelete")]
+        public Output<bool?> RetainOnDelete { get; private set; } = null!;
+
+        /// <summary>
+        /// How many strings have been pinned, starting from 1.
+        /// </summary>
+        [Output("revision")]
+        public Output<int?> Revision { get; private set; } = null!;
+
+        /// <summary>
+        /// Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+        /// </summary>
+        [Output("rotateOnDrift")]
+        public Output<bool?> RotateOnDrift { get; private set; } = null!;
+
+        /// <summary>
+        /// A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+        /// </summary>
+        [Output("rotationGroup")]
+        public Output<string?> RotationGroup { get; private set; } = null!;
+
+        /// <summary>
+        /// The trigger value of the rotation group as of the last update.
+        /// </summary>
+        [Output("rotationGroupTrigger")]
+        public Output<string?> RotationGroupTrigger { get; private set; } = null!;
+
+        /// <summary>
+        /// Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+        /// </summary>
+        [Output("rotationMode")]
+        public Output<Pulumi.StatefulString.RotationMode?> RotationMode { get; private set; } = null!;
+
+        /// <summary>
+        /// The scrypt hash of the pinned string in PHC format, when `hashes.scrypt` is set.
+        /// </summary>
+        [Output("scryptHash")]
+        public Output<string?> ScryptHash { get; private set; } = null!;
+
+        /// <summary>
+        /// Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+        /// </summary>
+        [Output("sensitiveTriggers")]
+        public Output<ImmutableArray<string>> SensitiveTriggers { get; private set; } = null!;
+
+        /// <summary>
+        /// Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+        /// </summary>
+        [Output("source")]
+        public Output<Outputs.Source?> Source { get; private set; } = null!;
+
+        /// <summary>
+        /// Whether the source differed from the pinned string at the last refresh.
+        /// </summary>
+        [Output("sourceDrift")]
+        public Output<bool?> SourceDrift { get; private set; } = null!;
+
+        /// <summary>
+        /// Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+        /// </summary>
+        [Output("staged")]
+        public Output<bool?> Staged { get; private set; } = null!;
+
+        /// <summary>
+        /// The string to pin. Changes are only picked up when the string rotates.
+        /// </summary>
         [Output("string")]
-        public Output<string> String { get; private set; } = null!;
+        public Output<string?> String { get; private set; } = null!;
 
+        /// <summary>
+        /// Transforms applied in order to the pinned string to compute `result`.
+        /// </summary>
+        [Output("transforms")]
+        public Output<ImmutableArray<Outputs.Transform>> Transforms { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+        /// </summary>
         [Output("triggers")]
-        public Output<ImmutableDictionary<string, string>> Triggers { get; private set; } = null!;
+        public Output<ImmutableDictionary<string, object>?> Triggers { get; private set; } = null!;
+
+        /// <summary>
+        /// How long before expiry every diff starts to warn, such as `72h`.
+        /// </summary>
+        [Output("warnBefore")]
+        public Output<string?> WarnBefore { get; private set; } = null!;
 
 
         /// <summary>
@@ -26,7 +281,7 @@ namespace Pulumi.StatefulString
         /// <param name="name">The unique name of the resource</param>
         /// <param name="args">The arguments used to populate this resource's properties</param>
         /// <param name="options">A bag of options that control this resource's behavior</param>
-        public StatefulString(string name, StatefulStringArgs args, CustomResourceOptions? options = null)
+        public StatefulString(string name, StatefulStringArgs? args = null, CustomResourceOptions? options = null)
             : base("statefulString:index:StatefulString", name, args ?? new StatefulStringArgs(), MakeResourceOptions(options, ""))
         {
         }
@@ -41,6 +296,12 @@ namespace Pulumi.StatefulString
             var defaultOptions = new CustomResourceOptions
             {
                 Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "argon2Hash",
+                    "bcryptHash",
+                    "scryptHash",
+                },
             };
             var merged = CustomResourceOptions.Merge(defaultOptions, options);
             // Override the ID if one was specified for consistency with other language SDKs.
@@ -63,17 +324,186 @@ namespace Pulumi.StatefulString
 
     public sealed class StatefulStringArgs : global::Pulumi.ResourceArgs
     {
-        [Input("string", required: true)]
-        public Input<string> String { get; set; } = null!;
+        [Input("allowedValues")]
+        private InputList<string>? _allowedValues;
+
+        /// <summary>
+        /// The only values the string may take before it is pinned.
+        /// </summary>
+        public InputList<string> AllowedValues
+        {
+            get => _allowedValues ?? (_allowedValues = new InputList<string>());
+            set => _allowedValues = value;
+        }
+
+        /// <summary>
+        /// The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+        /// </summary>
+        [Input("charset")]
+        public Input<string>? Charset { get; set; }
+
+        /// <summary>
+        /// How long a pinned string lives, such as `720h`.
+        /// </summary>
+        [Input("expiresAfter")]
+        public Input<string>? ExpiresAfter { get; set; }
+
+        /// <summary>
+        /// A nonce: any change to it pins the string regardless of triggers.
+        /// </summary>
+        [Input("forceRotate")]
+        public Input<string>? ForceRotate { get; set; }
+
+        /// <summary>
+        /// How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+        /// </summary>
+        [Input("generator")]
+        public Input<Inputs.GeneratorArgs>? Generator { get; set; }
+
+        /// <summary>
+        /// The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+        /// </summary>
+        [Input("hashes")]
+        public Input<Inputs.HashOptionsArgs>? Hashes { get; set; }
+
+        /// <summary>
+        /// A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+        /// </summary>
+        [Input("jsonSchema")]
+        public Input<string>? JsonSchema { get; set; }
+
+        /// <summary>
+        /// The most characters the string may have before it is pinned.
+        /// </summary>
+        [Input("maxLength")]
+        public Input<int>? MaxLength { get; set; }
+
+        /// <summary>
+        /// The fewest characters the string may have before it is pinned.
+        /// </summary>
+        [Input("minLength")]
+        public Input<int>? MinLength { get; set; }
+
+        /// <summary>
+        /// What happens once the pinned string has expired. Defaults to `warn`.
+        /// </summary>
+        [Input("onExpiry")]
+        public Input<Pulumi.StatefulString.ExpiryPolicy>? OnExpiry { get; set; }
+
+        /// <summary>
+        /// A regular expression the string must match before it is pinned.
+        /// </summary>
+        [Input("pattern")]
+        public Input<string>? Pattern { get; set; }
+
+        /// <summary>
+        /// How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+        /// </summary>
+        [Input("previousRetention")]
+        public Input<string>? PreviousRetention { get; set; }
+
+        /// <summary>
+        /// A nonce: any change to it pins the pending string.
+        /// </summary>
+        [Input("promote")]
+        public Input<string>? Promote { get; set; }
+
+        /// <summary>
+        /// Pin the pending string automatically on this many updates after it was staged.
+        /// </summary>
+        [Input("promoteAfterUpdates")]
+        public Input<int>? PromoteAfterUpdates { get; set; }
+
+        /// <summary>
+        /// The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+        /// </summary>
+        [Input("restoreFrom")]
+        public Input<string>? RestoreFrom { get; set; }
+
+        /// <summary>
+        /// Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+        /// </summary>
+        [Input("retainOnDelete")]
+        public Input<bool>? RetainOnDelete { get; set; }
+
+        /// <summary>
+        /// Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+        /// </summary>
+        [Input("rotateOnDrift")]
+        public Input<bool>? RotateOnDrift { get; set; }
+
+        /// <summary>
+        /// A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+        /// </summary>
+        [Input("rotationGroup")]
+        public Input<string>? RotationGroup { get; set; }
+
+        /// <summary>
+        /// Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+        /// </summary>
+        [Input("rotationMode")]
+        public Input<Pulumi.StatefulString.RotationMode>? RotationMode { get; set; }
+
+        [Input("sensitiveTriggers")]
+        private InputList<string>? _sensitiveTriggers;
 
-        [Input("triggers", required: true)]
-        private InputMap<string>? _triggers;
-        public InputMap<string> Triggers
+        /// <summary>
+        /// Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+        /// </summary>
+        public InputList<string> SensitiveTriggers
+        {
+            get => _sensitiveTriggers ?? (_sensitiveTriggers = new InputList<string>());
+            set => _sensitiveTriggers = value;
+        }
+
+        /// <summary>
+        /// Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+        /// </summary>
+        [Input("source")]
+        public Input<Inputs.SourceArgs>? Source { get; set; }
+
+        /// <summary>
+        /// Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+        /// </summary>
+        [Input("staged")]
+        public Input<bool>? Staged { get; set; }
+
+        /// <summary>
+        /// The string to pin. Changes are only picked up when the string rotates.
+        /// </summary>
+        [Input("string")]
+        public Input<string>? String { get; set; }
+
+        [Input("transforms")]
+        private InputList<Inputs.TransformArgs>? _transforms;
+
+        /// <summary>
+        /// Transforms applied in order to the pinned string to compute `result`.
+        /// </summary>
+        public InputList<Inputs.TransformArgs> Transforms
         {
-            get => _triggers ?? (_triggers = new InputMap<string>());
+            get => _transforms ?? (_transforms = new InputList<Inputs.TransformArgs>());
+            set => _transforms = value;
+        }
+
+        [Input("triggers")]
+        private InputMap<object>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+        /// </summary>
+        public InputMap<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<object>());
             set => _triggers = value;
         }
 
+        /// <summary>
+        /// How long before expiry every diff starts to warn, such as `72h`.
+        /// </summary>
+        [Input("warnBefore")]
+        public Input<string>? WarnBefore { get; set; }
+
         public StatefulStringArgs()
         {
         }
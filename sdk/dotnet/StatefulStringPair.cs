@@ -0,0 +1,114 @@
+// *** WARNING: this file was generated by pulumi. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+using System;
+using System.Collections.Generic;
+using System.Collections.Immutable;
+using System.Threading.Tasks;
+using Pulumi.Serialization;
+
+namespace Pulumi.StatefulString
+{
+    /// <summary>
+    /// Two or more named strings, such as a username and a password, that share one set of triggers and always rotate together.
+    /// </summary>
+    [StatefulStringResourceType("statefulString:index:StatefulStringPair")]
+    public partial class StatefulStringPair : global::Pulumi.CustomResource
+    {
+        /// <summary>
+        /// The fields to pin, by name. At least two are required.
+        /// </summary>
+        [Output("fields")]
+        public Output<ImmutableDictionary<string, Outputs.PairField>> Fields { get; private set; } = null!;
+
+        /// <summary>
+        /// Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+        /// </summary>
+        [Output("triggers")]
+        public Output<ImmutableDictionary<string, object>?> Triggers { get; private set; } = null!;
+
+        /// <summary>
+        /// The pinned value of every field, by field name.
+        /// </summary>
+        [Output("values")]
+        public Output<ImmutableDictionary<string, string>> Values { get; private set; } = null!;
+
+
+        /// <summary>
+        /// Create a StatefulStringPair resource with the given unique name, arguments, and options.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resource</param>
+        /// <param name="args">The arguments used to populate this resource's properties</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public StatefulStringPair(string name, StatefulStringPairArgs args, CustomResourceOptions? options = null)
+            : base("statefulString:index:StatefulStringPair", name, args ?? new StatefulStringPairArgs(), MakeResourceOptions(options, ""))
+        {
+        }
+
+        private StatefulStringPair(string name, Input<string> id, CustomResourceOptions? options = null)
+            : base("statefulString:index:StatefulStringPair", name, null, MakeResourceOptions(options, id))
+        {
+        }
+
+        private static CustomResourceOptions MakeResourceOptions(CustomResourceOptions? options, Input<string>? id)
+        {
+            var defaultOptions = new CustomResourceOptions
+            {
+                Version = Utilities.Version,
+                AdditionalSecretOutputs =
+                {
+                    "values",
+                },
+            };
+            var merged = CustomResourceOptions.Merge(defaultOptions, options);
+            // Override the ID if one was specified for consistency with other language SDKs.
+            merged.Id = id ?? merged.Id;
+            return merged;
+        }
+        /// <summary>
+        /// Get an existing StatefulStringPair resource's state with the given name, ID, and optional extra
+        /// properties used to qualify the lookup.
+        /// </summary>
+        ///
+        /// <param name="name">The unique name of the resulting resource.</param>
+        /// <param name="id">The unique provider ID of the resource to lookup.</param>
+        /// <param name="options">A bag of options that control this resource's behavior</param>
+        public static StatefulStringPair Get(string name, Input<string> id, CustomResourceOptions? options = null)
+        {
+            return new StatefulStringPair(name, id, options);
+        }
+    }
+
+    public sealed class StatefulStringPairArgs : global::Pulumi.ResourceArgs
+    {
+        [Input("fields", required: true)]
+        private InputMap<Inputs.PairFieldArgs>? _fields;
+
+        /// <summary>
+        /// The fields to pin, by name. At least two are required.
+        /// </summary>
+        public InputMap<Inputs.PairFieldArgs> Fields
+        {
+            get => _fields ?? (_fields = new InputMap<Inputs.PairFieldArgs>());
+            set => _fields = value;
+        }
+
+        [Input("triggers")]
+        private InputMap<object>? _triggers;
+
+        /// <summary>
+        /// Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+        /// </summary>
+        public InputMap<object> Triggers
+        {
+            get => _triggers ?? (_triggers = new InputMap<object>());
+            set => _triggers = value;
+        }
+
+        public StatefulStringPairArgs()
+        {
+        }
+        public static new StatefulStringPairArgs Empty => new StatefulStringPairArgs();
+    }
+}
@@ -0,0 +1,32 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package config
+
+import (
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi/config"
+)
+
+var _ = internal.GetEnvOrDefault
+
+// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+func GetArchiveDir(ctx *pulumi.Context) string {
+	return config.Get(ctx, "statefulString:archiveDir")
+}
+
+// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+func GetDeriveSeed(ctx *pulumi.Context) string {
+	return config.Get(ctx, "statefulString:deriveSeed")
+}
+
+// The path of the JSON file rotation groups are read from.
+func GetRotationManifest(ctx *pulumi.Context) string {
+	return config.Get(ctx, "statefulString:rotationManifest")
+}
+
+// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+func GetSharedStoreDir(ctx *pulumi.Context) string {
+	return config.Get(ctx, "statefulString:sharedStoreDir")
+}
@@ -0,0 +1,92 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package statefulString
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Reads a string published by a SharedStatefulString, possibly from another stack.
+func LookupSharedStatefulString(ctx *pulumi.Context, args *LookupSharedStatefulStringArgs, opts ...pulumi.InvokeOption) (*LookupSharedStatefulStringResult, error) {
+	opts = internal.PkgInvokeDefaultOpts(opts)
+	var rv LookupSharedStatefulStringResult
+	err := ctx.Invoke("statefulString:index:getSharedStatefulString", args, &rv, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+type LookupSharedStatefulStringArgs struct {
+	// The name of the record in the shared store.
+	Key string `pulumi:"key"`
+}
+
+type LookupSharedStatefulStringResult struct {
+	// The published string.
+	String string `pulumi:"string"`
+	// The triggers the string was last pinned with.
+	Triggers map[string]interface{} `pulumi:"triggers"`
+	// The RFC 3339 time at which the record was last published.
+	UpdatedAt string `pulumi:"updatedAt"`
+}
+
+func LookupSharedStatefulStringOutput(ctx *pulumi.Context, args LookupSharedStatefulStringOutputArgs, opts ...pulumi.InvokeOption) LookupSharedStatefulStringResultOutput {
+	return pulumi.ToOutputWithContext(context.Background(), args).
+		ApplyT(func(v interface{}) (LookupSharedStatefulStringResult, error) {
+			args := v.(LookupSharedStatefulStringArgs)
+			r, err := LookupSharedStatefulString(ctx, &args, opts...)
+			var s LookupSharedStatefulStringResult
+			if r != nil {
+				s = *r
+			}
+			return s, err
+		}).(LookupSharedStatefulStringResultOutput)
+}
+
+type LookupSharedStatefulStringOutputArgs struct {
+	// The name of the record in the shared store.
+	Key pulumi.StringInput `pulumi:"key"`
+}
+
+func (LookupSharedStatefulStringOutputArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupSharedStatefulStringArgs)(nil)).Elem()
+}
+
+type LookupSharedStatefulStringResultOutput struct{ *pulumi.OutputState }
+
+func (LookupSharedStatefulStringResultOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*LookupSharedStatefulStringResult)(nil)).Elem()
+}
+
+func (o LookupSharedStatefulStringResultOutput) ToLookupSharedStatefulStringResultOutput() LookupSharedStatefulStringResultOutput {
+	return o
+}
+
+func (o LookupSharedStatefulStringResultOutput) ToLookupSharedStatefulStringResultOutputWithContext(ctx context.Context) LookupSharedStatefulStringResultOutput {
+	return o
+}
+
+// The published string.
+func (o LookupSharedStatefulStringResultOutput) String() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupSharedStatefulStringResult) string { return v.String }).(pulumi.StringOutput)
+}
+
+// The triggers the string was last pinned with.
+func (o LookupSharedStatefulStringResultOutput) Triggers() pulumi.MapOutput {
+	return o.ApplyT(func(v LookupSharedStatefulStringResult) map[string]interface{} { return v.Triggers }).(pulumi.MapOutput)
+}
+
+// The RFC 3339 time at which the record was last published.
+func (o LookupSharedStatefulStringResultOutput) UpdatedAt() pulumi.StringOutput {
+	return o.ApplyT(func(v LookupSharedStatefulStringResult) string { return v.UpdatedAt }).(pulumi.StringOutput)
+}
+
+func init() {
+	pulumi.RegisterOutputType(LookupSharedStatefulStringResultOutput{})
+}
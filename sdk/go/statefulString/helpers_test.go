@@ -0,0 +1,73 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is written by hand. The Makefile lays it over the generated SDK.
+
+package statefulString
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggersFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600))
+
+	triggers, err := TriggersFromFiles(path)
+	require.NoError(t, err)
+	assert.Equal(t, pulumi.Map{
+		"config_json": pulumi.String("44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a"),
+	}, triggers)
+
+	_, err = TriggersFromFiles(filepath.Join(dir, "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// Files in different directories can still collide on their key
+	other := filepath.Join(dir, "other", "config.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(other), 0o700))
+	require.NoError(t, os.WriteFile(other, []byte("{}"), 0o600))
+	_, err = TriggersFromFiles(path, other)
+	assert.ErrorContains(t, err, `another file already uses the trigger key "config_json"`)
+}
+
+func TestImageDigest(t *testing.T) {
+	for _, tc := range []struct {
+		image    string
+		expected string
+	}{
+		{"repo:tag@sha256:f00d", "sha256:f00d"},
+		{"registry:5000/repo@sha256:f00d", "sha256:f00d"},
+		{"repo:tag", "repo:tag"},
+	} {
+		t.Run(tc.image, func(t *testing.T) {
+			assert.Equal(t, tc.expected, imageDigest(tc.image))
+		})
+	}
+}
+
+func TestMergeTriggers(t *testing.T) {
+	merged := MergeTriggers(
+		pulumi.Map{"a": pulumi.String("1"), "b": pulumi.String("1")},
+		pulumi.Map{"b": pulumi.String("2")},
+		nil,
+	)
+	assert.Equal(t, pulumi.Map{"a": pulumi.String("1"), "b": pulumi.String("2")}, merged)
+}
@@ -7,8 +7,8 @@ import (
 	"fmt"
 
 	"github.com/blang/semver"
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
-	"internal"
 )
 
 type module struct {
@@ -21,8 +21,18 @@ func (m *module) Version() semver.Version {
 
 func (m *module) Construct(ctx *pulumi.Context, name, typ, urn string) (r pulumi.Resource, err error) {
 	switch typ {
+	case "statefulString:index:SecretBundle":
+		r = &SecretBundle{}
+	case "statefulString:index:SharedStatefulString":
+		r = &SharedStatefulString{}
+	case "statefulString:index:StatefulCertificate":
+		r = &StatefulCertificate{}
+	case "statefulString:index:StatefulKeyPair":
+		r = &StatefulKeyPair{}
 	case "statefulString:index:StatefulString":
 		r = &StatefulString{}
+	case "statefulString:index:StatefulStringPair":
+		r = &StatefulStringPair{}
 	default:
 		return nil, fmt.Errorf("unknown resource type: %s", typ)
 	}
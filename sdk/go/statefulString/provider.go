@@ -7,12 +7,21 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
-	"internal"
 )
 
 type Provider struct {
 	pulumi.ProviderResourceState
+
+	// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+	ArchiveDir pulumi.StringPtrOutput `pulumi:"archiveDir"`
+	// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+	DeriveSeed pulumi.StringPtrOutput `pulumi:"deriveSeed"`
+	// The path of the JSON file rotation groups are read from.
+	RotationManifest pulumi.StringPtrOutput `pulumi:"rotationManifest"`
+	// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+	SharedStoreDir pulumi.StringPtrOutput `pulumi:"sharedStoreDir"`
 }
 
 // NewProvider registers a new resource with the given unique name, arguments, and options.
@@ -22,6 +31,13 @@ func NewProvider(ctx *pulumi.Context,
 		args = &ProviderArgs{}
 	}
 
+	if args.DeriveSeed != nil {
+		args.DeriveSeed = pulumi.ToSecret(args.DeriveSeed).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"deriveSeed",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource Provider
 	err := ctx.RegisterResource("pulumi:providers:statefulString", name, args, &resource, opts...)
@@ -32,10 +48,26 @@ func NewProvider(ctx *pulumi.Context,
 }
 
 type providerArgs struct {
+	// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+	ArchiveDir *string `pulumi:"archiveDir"`
+	// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+	DeriveSeed *string `pulumi:"deriveSeed"`
+	// The path of the JSON file rotation groups are read from.
+	RotationManifest *string `pulumi:"rotationManifest"`
+	// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+	SharedStoreDir *string `pulumi:"sharedStoreDir"`
 }
 
 // The set of arguments for constructing a Provider resource.
 type ProviderArgs struct {
+	// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+	ArchiveDir pulumi.StringPtrInput
+	// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+	DeriveSeed pulumi.StringPtrInput
+	// The path of the JSON file rotation groups are read from.
+	RotationManifest pulumi.StringPtrInput
+	// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+	SharedStoreDir pulumi.StringPtrInput
 }
 
 func (ProviderArgs) ElementType() reflect.Type {
@@ -75,6 +107,26 @@ func (o ProviderOutput) ToProviderOutputWithContext(ctx context.Context) Provide
 	return o
 }
 
+// The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+func (o ProviderOutput) ArchiveDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.ArchiveDir }).(pulumi.StringPtrOutput)
+}
+
+// The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+func (o ProviderOutput) DeriveSeed() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.DeriveSeed }).(pulumi.StringPtrOutput)
+}
+
+// The path of the JSON file rotation groups are read from.
+func (o ProviderOutput) RotationManifest() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.RotationManifest }).(pulumi.StringPtrOutput)
+}
+
+// The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+func (o ProviderOutput) SharedStoreDir() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Provider) pulumi.StringPtrOutput { return v.SharedStoreDir }).(pulumi.StringPtrOutput)
+}
+
 func init() {
 	pulumi.RegisterInputType(reflect.TypeOf((*ProviderInput)(nil)).Elem(), &Provider{})
 	pulumi.RegisterOutputType(ProviderOutput{})
@@ -0,0 +1,1244 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package statefulString
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+type ECDSACurve string
+
+const (
+	// NIST P-256.
+	ECDSACurveP256 = ECDSACurve("P256")
+	// NIST P-384.
+	ECDSACurveP384 = ECDSACurve("P384")
+	// NIST P-521.
+	ECDSACurveP521 = ECDSACurve("P521")
+)
+
+func (ECDSACurve) ElementType() reflect.Type {
+	return reflect.TypeOf((*ECDSACurve)(nil)).Elem()
+}
+
+func (e ECDSACurve) ToECDSACurveOutput() ECDSACurveOutput {
+	return pulumi.ToOutput(e).(ECDSACurveOutput)
+}
+
+func (e ECDSACurve) ToECDSACurveOutputWithContext(ctx context.Context) ECDSACurveOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ECDSACurveOutput)
+}
+
+func (e ECDSACurve) ToECDSACurvePtrOutput() ECDSACurvePtrOutput {
+	return e.ToECDSACurvePtrOutputWithContext(context.Background())
+}
+
+func (e ECDSACurve) ToECDSACurvePtrOutputWithContext(ctx context.Context) ECDSACurvePtrOutput {
+	return ECDSACurve(e).ToECDSACurveOutputWithContext(ctx).ToECDSACurvePtrOutputWithContext(ctx)
+}
+
+func (e ECDSACurve) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ECDSACurve) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ECDSACurve) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ECDSACurve) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ECDSACurveOutput struct{ *pulumi.OutputState }
+
+func (ECDSACurveOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ECDSACurve)(nil)).Elem()
+}
+
+func (o ECDSACurveOutput) ToECDSACurveOutput() ECDSACurveOutput {
+	return o
+}
+
+func (o ECDSACurveOutput) ToECDSACurveOutputWithContext(ctx context.Context) ECDSACurveOutput {
+	return o
+}
+
+func (o ECDSACurveOutput) ToECDSACurvePtrOutput() ECDSACurvePtrOutput {
+	return o.ToECDSACurvePtrOutputWithContext(context.Background())
+}
+
+func (o ECDSACurveOutput) ToECDSACurvePtrOutputWithContext(ctx context.Context) ECDSACurvePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ECDSACurve) *ECDSACurve {
+		return &v
+	}).(ECDSACurvePtrOutput)
+}
+
+func (o ECDSACurveOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o ECDSACurveOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ECDSACurve) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o ECDSACurveOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ECDSACurveOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ECDSACurve) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type ECDSACurvePtrOutput struct{ *pulumi.OutputState }
+
+func (ECDSACurvePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ECDSACurve)(nil)).Elem()
+}
+
+func (o ECDSACurvePtrOutput) ToECDSACurvePtrOutput() ECDSACurvePtrOutput {
+	return o
+}
+
+func (o ECDSACurvePtrOutput) ToECDSACurvePtrOutputWithContext(ctx context.Context) ECDSACurvePtrOutput {
+	return o
+}
+
+func (o ECDSACurvePtrOutput) Elem() ECDSACurveOutput {
+	return o.ApplyT(func(v *ECDSACurve) ECDSACurve {
+		if v != nil {
+			return *v
+		}
+		var ret ECDSACurve
+		return ret
+	}).(ECDSACurveOutput)
+}
+
+func (o ECDSACurvePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ECDSACurvePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *ECDSACurve) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// ECDSACurveInput is an input type that accepts values of the ECDSACurve enum
+// A concrete instance of `ECDSACurveInput` can be one of the following:
+//
+//	ECDSACurveP256
+//	ECDSACurveP384
+//	ECDSACurveP521
+type ECDSACurveInput interface {
+	pulumi.Input
+
+	ToECDSACurveOutput() ECDSACurveOutput
+	ToECDSACurveOutputWithContext(context.Context) ECDSACurveOutput
+}
+
+var ecdsacurvePtrType = reflect.TypeOf((**ECDSACurve)(nil)).Elem()
+
+type ECDSACurvePtrInput interface {
+	pulumi.Input
+
+	ToECDSACurvePtrOutput() ECDSACurvePtrOutput
+	ToECDSACurvePtrOutputWithContext(context.Context) ECDSACurvePtrOutput
+}
+
+type ecdsacurvePtr string
+
+func ECDSACurvePtr(v string) ECDSACurvePtrInput {
+	return (*ecdsacurvePtr)(&v)
+}
+
+func (*ecdsacurvePtr) ElementType() reflect.Type {
+	return ecdsacurvePtrType
+}
+
+func (in *ecdsacurvePtr) ToECDSACurvePtrOutput() ECDSACurvePtrOutput {
+	return pulumi.ToOutput(in).(ECDSACurvePtrOutput)
+}
+
+func (in *ecdsacurvePtr) ToECDSACurvePtrOutputWithContext(ctx context.Context) ECDSACurvePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(ECDSACurvePtrOutput)
+}
+
+type ExpiryPolicy string
+
+const (
+	// Emit a warning on every diff. This is the default.
+	ExpiryPolicyWarn = ExpiryPolicy("warn")
+	// Fail the update until the string is rotated.
+	ExpiryPolicyFail = ExpiryPolicy("fail")
+	// Pin the current string input as if a trigger changed.
+	ExpiryPolicyRotate = ExpiryPolicy("rotate")
+)
+
+func (ExpiryPolicy) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExpiryPolicy)(nil)).Elem()
+}
+
+func (e ExpiryPolicy) ToExpiryPolicyOutput() ExpiryPolicyOutput {
+	return pulumi.ToOutput(e).(ExpiryPolicyOutput)
+}
+
+func (e ExpiryPolicy) ToExpiryPolicyOutputWithContext(ctx context.Context) ExpiryPolicyOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(ExpiryPolicyOutput)
+}
+
+func (e ExpiryPolicy) ToExpiryPolicyPtrOutput() ExpiryPolicyPtrOutput {
+	return e.ToExpiryPolicyPtrOutputWithContext(context.Background())
+}
+
+func (e ExpiryPolicy) ToExpiryPolicyPtrOutputWithContext(ctx context.Context) ExpiryPolicyPtrOutput {
+	return ExpiryPolicy(e).ToExpiryPolicyOutputWithContext(ctx).ToExpiryPolicyPtrOutputWithContext(ctx)
+}
+
+func (e ExpiryPolicy) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ExpiryPolicy) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e ExpiryPolicy) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e ExpiryPolicy) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type ExpiryPolicyOutput struct{ *pulumi.OutputState }
+
+func (ExpiryPolicyOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ExpiryPolicy)(nil)).Elem()
+}
+
+func (o ExpiryPolicyOutput) ToExpiryPolicyOutput() ExpiryPolicyOutput {
+	return o
+}
+
+func (o ExpiryPolicyOutput) ToExpiryPolicyOutputWithContext(ctx context.Context) ExpiryPolicyOutput {
+	return o
+}
+
+func (o ExpiryPolicyOutput) ToExpiryPolicyPtrOutput() ExpiryPolicyPtrOutput {
+	return o.ToExpiryPolicyPtrOutputWithContext(context.Background())
+}
+
+func (o ExpiryPolicyOutput) ToExpiryPolicyPtrOutputWithContext(ctx context.Context) ExpiryPolicyPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ExpiryPolicy) *ExpiryPolicy {
+		return &v
+	}).(ExpiryPolicyPtrOutput)
+}
+
+func (o ExpiryPolicyOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o ExpiryPolicyOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ExpiryPolicy) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o ExpiryPolicyOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ExpiryPolicyOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e ExpiryPolicy) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type ExpiryPolicyPtrOutput struct{ *pulumi.OutputState }
+
+func (ExpiryPolicyPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ExpiryPolicy)(nil)).Elem()
+}
+
+func (o ExpiryPolicyPtrOutput) ToExpiryPolicyPtrOutput() ExpiryPolicyPtrOutput {
+	return o
+}
+
+func (o ExpiryPolicyPtrOutput) ToExpiryPolicyPtrOutputWithContext(ctx context.Context) ExpiryPolicyPtrOutput {
+	return o
+}
+
+func (o ExpiryPolicyPtrOutput) Elem() ExpiryPolicyOutput {
+	return o.ApplyT(func(v *ExpiryPolicy) ExpiryPolicy {
+		if v != nil {
+			return *v
+		}
+		var ret ExpiryPolicy
+		return ret
+	}).(ExpiryPolicyOutput)
+}
+
+func (o ExpiryPolicyPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o ExpiryPolicyPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *ExpiryPolicy) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// ExpiryPolicyInput is an input type that accepts values of the ExpiryPolicy enum
+// A concrete instance of `ExpiryPolicyInput` can be one of the following:
+//
+//	ExpiryPolicyWarn
+//	ExpiryPolicyFail
+//	ExpiryPolicyRotate
+type ExpiryPolicyInput interface {
+	pulumi.Input
+
+	ToExpiryPolicyOutput() ExpiryPolicyOutput
+	ToExpiryPolicyOutputWithContext(context.Context) ExpiryPolicyOutput
+}
+
+var expiryPolicyPtrType = reflect.TypeOf((**ExpiryPolicy)(nil)).Elem()
+
+type ExpiryPolicyPtrInput interface {
+	pulumi.Input
+
+	ToExpiryPolicyPtrOutput() ExpiryPolicyPtrOutput
+	ToExpiryPolicyPtrOutputWithContext(context.Context) ExpiryPolicyPtrOutput
+}
+
+type expiryPolicyPtr string
+
+func ExpiryPolicyPtr(v string) ExpiryPolicyPtrInput {
+	return (*expiryPolicyPtr)(&v)
+}
+
+func (*expiryPolicyPtr) ElementType() reflect.Type {
+	return expiryPolicyPtrType
+}
+
+func (in *expiryPolicyPtr) ToExpiryPolicyPtrOutput() ExpiryPolicyPtrOutput {
+	return pulumi.ToOutput(in).(ExpiryPolicyPtrOutput)
+}
+
+func (in *expiryPolicyPtr) ToExpiryPolicyPtrOutputWithContext(ctx context.Context) ExpiryPolicyPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(ExpiryPolicyPtrOutput)
+}
+
+type GeneratorKind string
+
+const (
+	// Draw `length` characters uniformly at random from `charset`.
+	GeneratorKindRandom = GeneratorKind("random")
+	// Compute `length` characters from `charset` with an HMAC-SHA256 of the triggers, keyed by the provider's `deriveSeed`. The same seed and triggers always derive the same value.
+	GeneratorKindDerive = GeneratorKind("derive")
+	// Join `words` words from an embedded wordlist with `separator`: adjectives followed by a noun, such as `brave-otter`.
+	GeneratorKindPetname = GeneratorKind("petname")
+)
+
+func (GeneratorKind) ElementType() reflect.Type {
+	return reflect.TypeOf((*GeneratorKind)(nil)).Elem()
+}
+
+func (e GeneratorKind) ToGeneratorKindOutput() GeneratorKindOutput {
+	return pulumi.ToOutput(e).(GeneratorKindOutput)
+}
+
+func (e GeneratorKind) ToGeneratorKindOutputWithContext(ctx context.Context) GeneratorKindOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(GeneratorKindOutput)
+}
+
+func (e GeneratorKind) ToGeneratorKindPtrOutput() GeneratorKindPtrOutput {
+	return e.ToGeneratorKindPtrOutputWithContext(context.Background())
+}
+
+func (e GeneratorKind) ToGeneratorKindPtrOutputWithContext(ctx context.Context) GeneratorKindPtrOutput {
+	return GeneratorKind(e).ToGeneratorKindOutputWithContext(ctx).ToGeneratorKindPtrOutputWithContext(ctx)
+}
+
+func (e GeneratorKind) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e GeneratorKind) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e GeneratorKind) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e GeneratorKind) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type GeneratorKindOutput struct{ *pulumi.OutputState }
+
+func (GeneratorKindOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*GeneratorKind)(nil)).Elem()
+}
+
+func (o GeneratorKindOutput) ToGeneratorKindOutput() GeneratorKindOutput {
+	return o
+}
+
+func (o GeneratorKindOutput) ToGeneratorKindOutputWithContext(ctx context.Context) GeneratorKindOutput {
+	return o
+}
+
+func (o GeneratorKindOutput) ToGeneratorKindPtrOutput() GeneratorKindPtrOutput {
+	return o.ToGeneratorKindPtrOutputWithContext(context.Background())
+}
+
+func (o GeneratorKindOutput) ToGeneratorKindPtrOutputWithContext(ctx context.Context) GeneratorKindPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v GeneratorKind) *GeneratorKind {
+		return &v
+	}).(GeneratorKindPtrOutput)
+}
+
+func (o GeneratorKindOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o GeneratorKindOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e GeneratorKind) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o GeneratorKindOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o GeneratorKindOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e GeneratorKind) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type GeneratorKindPtrOutput struct{ *pulumi.OutputState }
+
+func (GeneratorKindPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**GeneratorKind)(nil)).Elem()
+}
+
+func (o GeneratorKindPtrOutput) ToGeneratorKindPtrOutput() GeneratorKindPtrOutput {
+	return o
+}
+
+func (o GeneratorKindPtrOutput) ToGeneratorKindPtrOutputWithContext(ctx context.Context) GeneratorKindPtrOutput {
+	return o
+}
+
+func (o GeneratorKindPtrOutput) Elem() GeneratorKindOutput {
+	return o.ApplyT(func(v *GeneratorKind) GeneratorKind {
+		if v != nil {
+			return *v
+		}
+		var ret GeneratorKind
+		return ret
+	}).(GeneratorKindOutput)
+}
+
+func (o GeneratorKindPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o GeneratorKindPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *GeneratorKind) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// GeneratorKindInput is an input type that accepts values of the GeneratorKind enum
+// A concrete instance of `GeneratorKindInput` can be one of the following:
+//
+//	GeneratorKindRandom
+//	GeneratorKindDerive
+//	GeneratorKindPetname
+type GeneratorKindInput interface {
+	pulumi.Input
+
+	ToGeneratorKindOutput() GeneratorKindOutput
+	ToGeneratorKindOutputWithContext(context.Context) GeneratorKindOutput
+}
+
+var generatorKindPtrType = reflect.TypeOf((**GeneratorKind)(nil)).Elem()
+
+type GeneratorKindPtrInput interface {
+	pulumi.Input
+
+	ToGeneratorKindPtrOutput() GeneratorKindPtrOutput
+	ToGeneratorKindPtrOutputWithContext(context.Context) GeneratorKindPtrOutput
+}
+
+type generatorKindPtr string
+
+func GeneratorKindPtr(v string) GeneratorKindPtrInput {
+	return (*generatorKindPtr)(&v)
+}
+
+func (*generatorKindPtr) ElementType() reflect.Type {
+	return generatorKindPtrType
+}
+
+func (in *generatorKindPtr) ToGeneratorKindPtrOutput() GeneratorKindPtrOutput {
+	return pulumi.ToOutput(in).(GeneratorKindPtrOutput)
+}
+
+func (in *generatorKindPtr) ToGeneratorKindPtrOutputWithContext(ctx context.Context) GeneratorKindPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(GeneratorKindPtrOutput)
+}
+
+type KeyAlgorithm string
+
+const (
+	// An Ed25519 key.
+	KeyAlgorithmEd25519 = KeyAlgorithm("ed25519")
+	// An RSA key of `rsaBits` bits.
+	KeyAlgorithmRsa = KeyAlgorithm("rsa")
+	// An ECDSA key on `ecdsaCurve`.
+	KeyAlgorithmEcdsa = KeyAlgorithm("ecdsa")
+)
+
+func (KeyAlgorithm) ElementType() reflect.Type {
+	return reflect.TypeOf((*KeyAlgorithm)(nil)).Elem()
+}
+
+func (e KeyAlgorithm) ToKeyAlgorithmOutput() KeyAlgorithmOutput {
+	return pulumi.ToOutput(e).(KeyAlgorithmOutput)
+}
+
+func (e KeyAlgorithm) ToKeyAlgorithmOutputWithContext(ctx context.Context) KeyAlgorithmOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(KeyAlgorithmOutput)
+}
+
+func (e KeyAlgorithm) ToKeyAlgorithmPtrOutput() KeyAlgorithmPtrOutput {
+	return e.ToKeyAlgorithmPtrOutputWithContext(context.Background())
+}
+
+func (e KeyAlgorithm) ToKeyAlgorithmPtrOutputWithContext(ctx context.Context) KeyAlgorithmPtrOutput {
+	return KeyAlgorithm(e).ToKeyAlgorithmOutputWithContext(ctx).ToKeyAlgorithmPtrOutputWithContext(ctx)
+}
+
+func (e KeyAlgorithm) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e KeyAlgorithm) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e KeyAlgorithm) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e KeyAlgorithm) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type KeyAlgorithmOutput struct{ *pulumi.OutputState }
+
+func (KeyAlgorithmOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*KeyAlgorithm)(nil)).Elem()
+}
+
+func (o KeyAlgorithmOutput) ToKeyAlgorithmOutput() KeyAlgorithmOutput {
+	return o
+}
+
+func (o KeyAlgorithmOutput) ToKeyAlgorithmOutputWithContext(ctx context.Context) KeyAlgorithmOutput {
+	return o
+}
+
+func (o KeyAlgorithmOutput) ToKeyAlgorithmPtrOutput() KeyAlgorithmPtrOutput {
+	return o.ToKeyAlgorithmPtrOutputWithContext(context.Background())
+}
+
+func (o KeyAlgorithmOutput) ToKeyAlgorithmPtrOutputWithContext(ctx context.Context) KeyAlgorithmPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v KeyAlgorithm) *KeyAlgorithm {
+		return &v
+	}).(KeyAlgorithmPtrOutput)
+}
+
+func (o KeyAlgorithmOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o KeyAlgorithmOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e KeyAlgorithm) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o KeyAlgorithmOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o KeyAlgorithmOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e KeyAlgorithm) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type KeyAlgorithmPtrOutput struct{ *pulumi.OutputState }
+
+func (KeyAlgorithmPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**KeyAlgorithm)(nil)).Elem()
+}
+
+func (o KeyAlgorithmPtrOutput) ToKeyAlgorithmPtrOutput() KeyAlgorithmPtrOutput {
+	return o
+}
+
+func (o KeyAlgorithmPtrOutput) ToKeyAlgorithmPtrOutputWithContext(ctx context.Context) KeyAlgorithmPtrOutput {
+	return o
+}
+
+func (o KeyAlgorithmPtrOutput) Elem() KeyAlgorithmOutput {
+	return o.ApplyT(func(v *KeyAlgorithm) KeyAlgorithm {
+		if v != nil {
+			return *v
+		}
+		var ret KeyAlgorithm
+		return ret
+	}).(KeyAlgorithmOutput)
+}
+
+func (o KeyAlgorithmPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o KeyAlgorithmPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *KeyAlgorithm) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// KeyAlgorithmInput is an input type that accepts values of the KeyAlgorithm enum
+// A concrete instance of `KeyAlgorithmInput` can be one of the following:
+//
+//	KeyAlgorithmEd25519
+//	KeyAlgorithmRsa
+//	KeyAlgorithmEcdsa
+type KeyAlgorithmInput interface {
+	pulumi.Input
+
+	ToKeyAlgorithmOutput() KeyAlgorithmOutput
+	ToKeyAlgorithmOutputWithContext(context.Context) KeyAlgorithmOutput
+}
+
+var keyAlgorithmPtrType = reflect.TypeOf((**KeyAlgorithm)(nil)).Elem()
+
+type KeyAlgorithmPtrInput interface {
+	pulumi.Input
+
+	ToKeyAlgorithmPtrOutput() KeyAlgorithmPtrOutput
+	ToKeyAlgorithmPtrOutputWithContext(context.Context) KeyAlgorithmPtrOutput
+}
+
+type keyAlgorithmPtr string
+
+func KeyAlgorithmPtr(v string) KeyAlgorithmPtrInput {
+	return (*keyAlgorithmPtr)(&v)
+}
+
+func (*keyAlgorithmPtr) ElementType() reflect.Type {
+	return keyAlgorithmPtrType
+}
+
+func (in *keyAlgorithmPtr) ToKeyAlgorithmPtrOutput() KeyAlgorithmPtrOutput {
+	return pulumi.ToOutput(in).(KeyAlgorithmPtrOutput)
+}
+
+func (in *keyAlgorithmPtr) ToKeyAlgorithmPtrOutputWithContext(ctx context.Context) KeyAlgorithmPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(KeyAlgorithmPtrOutput)
+}
+
+type RotationMode string
+
+const (
+	// Pin the string whenever a trigger is added, removed or changed. This is the default.
+	RotationModeRotateOnTriggerChange = RotationMode("rotateOnTriggerChange")
+	// Pin the string whenever it or a trigger changes.
+	RotationModeRotateOnStringChange = RotationMode("rotateOnStringChange")
+	// Pin the string only when the forceRotate nonce changes.
+	RotationModeExplicit = RotationMode("explicit")
+)
+
+func (RotationMode) ElementType() reflect.Type {
+	return reflect.TypeOf((*RotationMode)(nil)).Elem()
+}
+
+func (e RotationMode) ToRotationModeOutput() RotationModeOutput {
+	return pulumi.ToOutput(e).(RotationModeOutput)
+}
+
+func (e RotationMode) ToRotationModeOutputWithContext(ctx context.Context) RotationModeOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(RotationModeOutput)
+}
+
+func (e RotationMode) ToRotationModePtrOutput() RotationModePtrOutput {
+	return e.ToRotationModePtrOutputWithContext(context.Background())
+}
+
+func (e RotationMode) ToRotationModePtrOutputWithContext(ctx context.Context) RotationModePtrOutput {
+	return RotationMode(e).ToRotationModeOutputWithContext(ctx).ToRotationModePtrOutputWithContext(ctx)
+}
+
+func (e RotationMode) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e RotationMode) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e RotationMode) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e RotationMode) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type RotationModeOutput struct{ *pulumi.OutputState }
+
+func (RotationModeOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*RotationMode)(nil)).Elem()
+}
+
+func (o RotationModeOutput) ToRotationModeOutput() RotationModeOutput {
+	return o
+}
+
+func (o RotationModeOutput) ToRotationModeOutputWithContext(ctx context.Context) RotationModeOutput {
+	return o
+}
+
+func (o RotationModeOutput) ToRotationModePtrOutput() RotationModePtrOutput {
+	return o.ToRotationModePtrOutputWithContext(context.Background())
+}
+
+func (o RotationModeOutput) ToRotationModePtrOutputWithContext(ctx context.Context) RotationModePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v RotationMode) *RotationMode {
+		return &v
+	}).(RotationModePtrOutput)
+}
+
+func (o RotationModeOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o RotationModeOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e RotationMode) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o RotationModeOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o RotationModeOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e RotationMode) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type RotationModePtrOutput struct{ *pulumi.OutputState }
+
+func (RotationModePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**RotationMode)(nil)).Elem()
+}
+
+func (o RotationModePtrOutput) ToRotationModePtrOutput() RotationModePtrOutput {
+	return o
+}
+
+func (o RotationModePtrOutput) ToRotationModePtrOutputWithContext(ctx context.Context) RotationModePtrOutput {
+	return o
+}
+
+func (o RotationModePtrOutput) Elem() RotationModeOutput {
+	return o.ApplyT(func(v *RotationMode) RotationMode {
+		if v != nil {
+			return *v
+		}
+		var ret RotationMode
+		return ret
+	}).(RotationModeOutput)
+}
+
+func (o RotationModePtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o RotationModePtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *RotationMode) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// RotationModeInput is an input type that accepts values of the RotationMode enum
+// A concrete instance of `RotationModeInput` can be one of the following:
+//
+//	RotationModeRotateOnTriggerChange
+//	RotationModeRotateOnStringChange
+//	RotationModeExplicit
+type RotationModeInput interface {
+	pulumi.Input
+
+	ToRotationModeOutput() RotationModeOutput
+	ToRotationModeOutputWithContext(context.Context) RotationModeOutput
+}
+
+var rotationModePtrType = reflect.TypeOf((**RotationMode)(nil)).Elem()
+
+type RotationModePtrInput interface {
+	pulumi.Input
+
+	ToRotationModePtrOutput() RotationModePtrOutput
+	ToRotationModePtrOutputWithContext(context.Context) RotationModePtrOutput
+}
+
+type rotationModePtr string
+
+func RotationModePtr(v string) RotationModePtrInput {
+	return (*rotationModePtr)(&v)
+}
+
+func (*rotationModePtr) ElementType() reflect.Type {
+	return rotationModePtrType
+}
+
+func (in *rotationModePtr) ToRotationModePtrOutput() RotationModePtrOutput {
+	return pulumi.ToOutput(in).(RotationModePtrOutput)
+}
+
+func (in *rotationModePtr) ToRotationModePtrOutputWithContext(ctx context.Context) RotationModePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(RotationModePtrOutput)
+}
+
+type SourceKind string
+
+const (
+	// Read the environment variable `name` of the provider process.
+	SourceKindEnv = SourceKind("env")
+	// Read the local file at `path`.
+	SourceKindFile = SourceKind("file")
+	// Run `command` and read its standard output.
+	SourceKindCommand = SourceKind("command")
+)
+
+func (SourceKind) ElementType() reflect.Type {
+	return reflect.TypeOf((*SourceKind)(nil)).Elem()
+}
+
+func (e SourceKind) ToSourceKindOutput() SourceKindOutput {
+	return pulumi.ToOutput(e).(SourceKindOutput)
+}
+
+func (e SourceKind) ToSourceKindOutputWithContext(ctx context.Context) SourceKindOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(SourceKindOutput)
+}
+
+func (e SourceKind) ToSourceKindPtrOutput() SourceKindPtrOutput {
+	return e.ToSourceKindPtrOutputWithContext(context.Background())
+}
+
+func (e SourceKind) ToSourceKindPtrOutputWithContext(ctx context.Context) SourceKindPtrOutput {
+	return SourceKind(e).ToSourceKindOutputWithContext(ctx).ToSourceKindPtrOutputWithContext(ctx)
+}
+
+func (e SourceKind) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SourceKind) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e SourceKind) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e SourceKind) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type SourceKindOutput struct{ *pulumi.OutputState }
+
+func (SourceKindOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*SourceKind)(nil)).Elem()
+}
+
+func (o SourceKindOutput) ToSourceKindOutput() SourceKindOutput {
+	return o
+}
+
+func (o SourceKindOutput) ToSourceKindOutputWithContext(ctx context.Context) SourceKindOutput {
+	return o
+}
+
+func (o SourceKindOutput) ToSourceKindPtrOutput() SourceKindPtrOutput {
+	return o.ToSourceKindPtrOutputWithContext(context.Background())
+}
+
+func (o SourceKindOutput) ToSourceKindPtrOutputWithContext(ctx context.Context) SourceKindPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v SourceKind) *SourceKind {
+		return &v
+	}).(SourceKindPtrOutput)
+}
+
+func (o SourceKindOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o SourceKindOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e SourceKind) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o SourceKindOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SourceKindOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e SourceKind) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type SourceKindPtrOutput struct{ *pulumi.OutputState }
+
+func (SourceKindPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SourceKind)(nil)).Elem()
+}
+
+func (o SourceKindPtrOutput) ToSourceKindPtrOutput() SourceKindPtrOutput {
+	return o
+}
+
+func (o SourceKindPtrOutput) ToSourceKindPtrOutputWithContext(ctx context.Context) SourceKindPtrOutput {
+	return o
+}
+
+func (o SourceKindPtrOutput) Elem() SourceKindOutput {
+	return o.ApplyT(func(v *SourceKind) SourceKind {
+		if v != nil {
+			return *v
+		}
+		var ret SourceKind
+		return ret
+	}).(SourceKindOutput)
+}
+
+func (o SourceKindPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o SourceKindPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *SourceKind) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// SourceKindInput is an input type that accepts values of the SourceKind enum
+// A concrete instance of `SourceKindInput` can be one of the following:
+//
+//	SourceKindEnv
+//	SourceKindFile
+//	SourceKindCommand
+type SourceKindInput interface {
+	pulumi.Input
+
+	ToSourceKindOutput() SourceKindOutput
+	ToSourceKindOutputWithContext(context.Context) SourceKindOutput
+}
+
+var sourceKindPtrType = reflect.TypeOf((**SourceKind)(nil)).Elem()
+
+type SourceKindPtrInput interface {
+	pulumi.Input
+
+	ToSourceKindPtrOutput() SourceKindPtrOutput
+	ToSourceKindPtrOutputWithContext(context.Context) SourceKindPtrOutput
+}
+
+type sourceKindPtr string
+
+func SourceKindPtr(v string) SourceKindPtrInput {
+	return (*sourceKindPtr)(&v)
+}
+
+func (*sourceKindPtr) ElementType() reflect.Type {
+	return sourceKindPtrType
+}
+
+func (in *sourceKindPtr) ToSourceKindPtrOutput() SourceKindPtrOutput {
+	return pulumi.ToOutput(in).(SourceKindPtrOutput)
+}
+
+func (in *sourceKindPtr) ToSourceKindPtrOutputWithContext(ctx context.Context) SourceKindPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(SourceKindPtrOutput)
+}
+
+type TransformKind string
+
+const (
+	// Lowercase the value.
+	TransformKindLower = TransformKind("lower")
+	// Uppercase the value.
+	TransformKindUpper = TransformKind("upper")
+	// Keep at most `length` characters (63 by default).
+	TransformKindTruncate = TransformKind("truncate")
+	// Reduce the value to lowercase letters, digits and single dashes.
+	TransformKindSlugify = TransformKind("slugify")
+	// Prepend `value`.
+	TransformKindPrefix = TransformKind("prefix")
+	// Append `value`.
+	TransformKindSuffix = TransformKind("suffix")
+	// Standard base64 encode the value.
+	TransformKindBase64 = TransformKind("base64")
+)
+
+func (TransformKind) ElementType() reflect.Type {
+	return reflect.TypeOf((*TransformKind)(nil)).Elem()
+}
+
+func (e TransformKind) ToTransformKindOutput() TransformKindOutput {
+	return pulumi.ToOutput(e).(TransformKindOutput)
+}
+
+func (e TransformKind) ToTransformKindOutputWithContext(ctx context.Context) TransformKindOutput {
+	return pulumi.ToOutputWithContext(ctx, e).(TransformKindOutput)
+}
+
+func (e TransformKind) ToTransformKindPtrOutput() TransformKindPtrOutput {
+	return e.ToTransformKindPtrOutputWithContext(context.Background())
+}
+
+func (e TransformKind) ToTransformKindPtrOutputWithContext(ctx context.Context) TransformKindPtrOutput {
+	return TransformKind(e).ToTransformKindOutputWithContext(ctx).ToTransformKindPtrOutputWithContext(ctx)
+}
+
+func (e TransformKind) ToStringOutput() pulumi.StringOutput {
+	return pulumi.ToOutput(pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e TransformKind) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return pulumi.ToOutputWithContext(ctx, pulumi.String(e)).(pulumi.StringOutput)
+}
+
+func (e TransformKind) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringPtrOutputWithContext(context.Background())
+}
+
+func (e TransformKind) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return pulumi.String(e).ToStringOutputWithContext(ctx).ToStringPtrOutputWithContext(ctx)
+}
+
+type TransformKindOutput struct{ *pulumi.OutputState }
+
+func (TransformKindOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*TransformKind)(nil)).Elem()
+}
+
+func (o TransformKindOutput) ToTransformKindOutput() TransformKindOutput {
+	return o
+}
+
+func (o TransformKindOutput) ToTransformKindOutputWithContext(ctx context.Context) TransformKindOutput {
+	return o
+}
+
+func (o TransformKindOutput) ToTransformKindPtrOutput() TransformKindPtrOutput {
+	return o.ToTransformKindPtrOutputWithContext(context.Background())
+}
+
+func (o TransformKindOutput) ToTransformKindPtrOutputWithContext(ctx context.Context) TransformKindPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v TransformKind) *TransformKind {
+		return &v
+	}).(TransformKindPtrOutput)
+}
+
+func (o TransformKindOutput) ToStringOutput() pulumi.StringOutput {
+	return o.ToStringOutputWithContext(context.Background())
+}
+
+func (o TransformKindOutput) ToStringOutputWithContext(ctx context.Context) pulumi.StringOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e TransformKind) string {
+		return string(e)
+	}).(pulumi.StringOutput)
+}
+
+func (o TransformKindOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o TransformKindOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e TransformKind) *string {
+		v := string(e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+type TransformKindPtrOutput struct{ *pulumi.OutputState }
+
+func (TransformKindPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**TransformKind)(nil)).Elem()
+}
+
+func (o TransformKindPtrOutput) ToTransformKindPtrOutput() TransformKindPtrOutput {
+	return o
+}
+
+func (o TransformKindPtrOutput) ToTransformKindPtrOutputWithContext(ctx context.Context) TransformKindPtrOutput {
+	return o
+}
+
+func (o TransformKindPtrOutput) Elem() TransformKindOutput {
+	return o.ApplyT(func(v *TransformKind) TransformKind {
+		if v != nil {
+			return *v
+		}
+		var ret TransformKind
+		return ret
+	}).(TransformKindOutput)
+}
+
+func (o TransformKindPtrOutput) ToStringPtrOutput() pulumi.StringPtrOutput {
+	return o.ToStringPtrOutputWithContext(context.Background())
+}
+
+func (o TransformKindPtrOutput) ToStringPtrOutputWithContext(ctx context.Context) pulumi.StringPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, e *TransformKind) *string {
+		if e == nil {
+			return nil
+		}
+		v := string(*e)
+		return &v
+	}).(pulumi.StringPtrOutput)
+}
+
+// TransformKindInput is an input type that accepts values of the TransformKind enum
+// A concrete instance of `TransformKindInput` can be one of the following:
+//
+//	TransformKindLower
+//	TransformKindUpper
+//	TransformKindTruncate
+//	TransformKindSlugify
+//	TransformKindPrefix
+//	TransformKindSuffix
+//	TransformKindBase64
+type TransformKindInput interface {
+	pulumi.Input
+
+	ToTransformKindOutput() TransformKindOutput
+	ToTransformKindOutputWithContext(context.Context) TransformKindOutput
+}
+
+var transformKindPtrType = reflect.TypeOf((**TransformKind)(nil)).Elem()
+
+type TransformKindPtrInput interface {
+	pulumi.Input
+
+	ToTransformKindPtrOutput() TransformKindPtrOutput
+	ToTransformKindPtrOutputWithContext(context.Context) TransformKindPtrOutput
+}
+
+type transformKindPtr string
+
+func TransformKindPtr(v string) TransformKindPtrInput {
+	return (*transformKindPtr)(&v)
+}
+
+func (*transformKindPtr) ElementType() reflect.Type {
+	return transformKindPtrType
+}
+
+func (in *transformKindPtr) ToTransformKindPtrOutput() TransformKindPtrOutput {
+	return pulumi.ToOutput(in).(TransformKindPtrOutput)
+}
+
+func (in *transformKindPtr) ToTransformKindPtrOutputWithContext(ctx context.Context) TransformKindPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, in).(TransformKindPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*ECDSACurveInput)(nil)).Elem(), ECDSACurve("P256"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ECDSACurvePtrInput)(nil)).Elem(), ECDSACurve("P256"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ExpiryPolicyInput)(nil)).Elem(), ExpiryPolicy("warn"))
+	pulumi.RegisterInputType(reflect.TypeOf((*ExpiryPolicyPtrInput)(nil)).Elem(), ExpiryPolicy("warn"))
+	pulumi.RegisterInputType(reflect.TypeOf((*GeneratorKindInput)(nil)).Elem(), GeneratorKind("random"))
+	pulumi.RegisterInputType(reflect.TypeOf((*GeneratorKindPtrInput)(nil)).Elem(), GeneratorKind("random"))
+	pulumi.RegisterInputType(reflect.TypeOf((*KeyAlgorithmInput)(nil)).Elem(), KeyAlgorithm("ed25519"))
+	pulumi.RegisterInputType(reflect.TypeOf((*KeyAlgorithmPtrInput)(nil)).Elem(), KeyAlgorithm("ed25519"))
+	pulumi.RegisterInputType(reflect.TypeOf((*RotationModeInput)(nil)).Elem(), RotationMode("rotateOnTriggerChange"))
+	pulumi.RegisterInputType(reflect.TypeOf((*RotationModePtrInput)(nil)).Elem(), RotationMode("rotateOnTriggerChange"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SourceKindInput)(nil)).Elem(), SourceKind("env"))
+	pulumi.RegisterInputType(reflect.TypeOf((*SourceKindPtrInput)(nil)).Elem(), SourceKind("env"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TransformKindInput)(nil)).Elem(), TransformKind("lower"))
+	pulumi.RegisterInputType(reflect.TypeOf((*TransformKindPtrInput)(nil)).Elem(), TransformKind("lower"))
+	pulumi.RegisterOutputType(ECDSACurveOutput{})
+	pulumi.RegisterOutputType(ECDSACurvePtrOutput{})
+	pulumi.RegisterOutputType(ExpiryPolicyOutput{})
+	pulumi.RegisterOutputType(ExpiryPolicyPtrOutput{})
+	pulumi.RegisterOutputType(GeneratorKindOutput{})
+	pulumi.RegisterOutputType(GeneratorKindPtrOutput{})
+	pulumi.RegisterOutputType(KeyAlgorithmOutput{})
+	pulumi.RegisterOutputType(KeyAlgorithmPtrOutput{})
+	pulumi.RegisterOutputType(RotationModeOutput{})
+	pulumi.RegisterOutputType(RotationModePtrOutput{})
+	pulumi.RegisterOutputType(SourceKindOutput{})
+	pulumi.RegisterOutputType(SourceKindPtrOutput{})
+	pulumi.RegisterOutputType(TransformKindOutput{})
+	pulumi.RegisterOutputType(TransformKindPtrOutput{})
+}
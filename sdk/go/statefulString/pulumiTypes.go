@@ -0,0 +1,1574 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package statefulString
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+var _ = internal.GetEnvOrDefault
+
+type Argon2Options struct {
+	// The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+	Memory *int `pulumi:"memory"`
+	// The degree of parallelism, from 1 to 255. Defaults to 4.
+	Threads *int `pulumi:"threads"`
+	// The number of passes over the memory, from 1 to 10. Defaults to 1.
+	Time *int `pulumi:"time"`
+}
+
+// Argon2OptionsInput is an input type that accepts Argon2OptionsArgs and Argon2OptionsOutput values.
+// You can construct a concrete instance of `Argon2OptionsInput` via:
+//
+//	Argon2OptionsArgs{...}
+type Argon2OptionsInput interface {
+	pulumi.Input
+
+	ToArgon2OptionsOutput() Argon2OptionsOutput
+	ToArgon2OptionsOutputWithContext(context.Context) Argon2OptionsOutput
+}
+
+type Argon2OptionsArgs struct {
+	// The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+	Memory pulumi.IntPtrInput `pulumi:"memory"`
+	// The degree of parallelism, from 1 to 255. Defaults to 4.
+	Threads pulumi.IntPtrInput `pulumi:"threads"`
+	// The number of passes over the memory, from 1 to 10. Defaults to 1.
+	Time pulumi.IntPtrInput `pulumi:"time"`
+}
+
+func (Argon2OptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Argon2Options)(nil)).Elem()
+}
+
+func (i Argon2OptionsArgs) ToArgon2OptionsOutput() Argon2OptionsOutput {
+	return i.ToArgon2OptionsOutputWithContext(context.Background())
+}
+
+func (i Argon2OptionsArgs) ToArgon2OptionsOutputWithContext(ctx context.Context) Argon2OptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(Argon2OptionsOutput)
+}
+
+func (i Argon2OptionsArgs) ToArgon2OptionsPtrOutput() Argon2OptionsPtrOutput {
+	return i.ToArgon2OptionsPtrOutputWithContext(context.Background())
+}
+
+func (i Argon2OptionsArgs) ToArgon2OptionsPtrOutputWithContext(ctx context.Context) Argon2OptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(Argon2OptionsOutput).ToArgon2OptionsPtrOutputWithContext(ctx)
+}
+
+// Argon2OptionsPtrInput is an input type that accepts Argon2OptionsArgs, Argon2OptionsPtr and Argon2OptionsPtrOutput values.
+// You can construct a concrete instance of `Argon2OptionsPtrInput` via:
+//
+//	        Argon2OptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type Argon2OptionsPtrInput interface {
+	pulumi.Input
+
+	ToArgon2OptionsPtrOutput() Argon2OptionsPtrOutput
+	ToArgon2OptionsPtrOutputWithContext(context.Context) Argon2OptionsPtrOutput
+}
+
+type argon2OptionsPtrType Argon2OptionsArgs
+
+func Argon2OptionsPtr(v *Argon2OptionsArgs) Argon2OptionsPtrInput {
+	return (*argon2OptionsPtrType)(v)
+}
+
+func (*argon2OptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Argon2Options)(nil)).Elem()
+}
+
+func (i *argon2OptionsPtrType) ToArgon2OptionsPtrOutput() Argon2OptionsPtrOutput {
+	return i.ToArgon2OptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *argon2OptionsPtrType) ToArgon2OptionsPtrOutputWithContext(ctx context.Context) Argon2OptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(Argon2OptionsPtrOutput)
+}
+
+type Argon2OptionsOutput struct{ *pulumi.OutputState }
+
+func (Argon2OptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Argon2Options)(nil)).Elem()
+}
+
+func (o Argon2OptionsOutput) ToArgon2OptionsOutput() Argon2OptionsOutput {
+	return o
+}
+
+func (o Argon2OptionsOutput) ToArgon2OptionsOutputWithContext(ctx context.Context) Argon2OptionsOutput {
+	return o
+}
+
+func (o Argon2OptionsOutput) ToArgon2OptionsPtrOutput() Argon2OptionsPtrOutput {
+	return o.ToArgon2OptionsPtrOutputWithContext(context.Background())
+}
+
+func (o Argon2OptionsOutput) ToArgon2OptionsPtrOutputWithContext(ctx context.Context) Argon2OptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Argon2Options) *Argon2Options {
+		return &v
+	}).(Argon2OptionsPtrOutput)
+}
+
+// The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+func (o Argon2OptionsOutput) Memory() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Argon2Options) *int { return v.Memory }).(pulumi.IntPtrOutput)
+}
+
+// The degree of parallelism, from 1 to 255. Defaults to 4.
+func (o Argon2OptionsOutput) Threads() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Argon2Options) *int { return v.Threads }).(pulumi.IntPtrOutput)
+}
+
+// The number of passes over the memory, from 1 to 10. Defaults to 1.
+func (o Argon2OptionsOutput) Time() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Argon2Options) *int { return v.Time }).(pulumi.IntPtrOutput)
+}
+
+type Argon2OptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (Argon2OptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Argon2Options)(nil)).Elem()
+}
+
+func (o Argon2OptionsPtrOutput) ToArgon2OptionsPtrOutput() Argon2OptionsPtrOutput {
+	return o
+}
+
+func (o Argon2OptionsPtrOutput) ToArgon2OptionsPtrOutputWithContext(ctx context.Context) Argon2OptionsPtrOutput {
+	return o
+}
+
+func (o Argon2OptionsPtrOutput) Elem() Argon2OptionsOutput {
+	return o.ApplyT(func(v *Argon2Options) Argon2Options {
+		if v != nil {
+			return *v
+		}
+		var ret Argon2Options
+		return ret
+	}).(Argon2OptionsOutput)
+}
+
+// The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+func (o Argon2OptionsPtrOutput) Memory() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Argon2Options) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Memory
+	}).(pulumi.IntPtrOutput)
+}
+
+// The degree of parallelism, from 1 to 255. Defaults to 4.
+func (o Argon2OptionsPtrOutput) Threads() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Argon2Options) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Threads
+	}).(pulumi.IntPtrOutput)
+}
+
+// The number of passes over the memory, from 1 to 10. Defaults to 1.
+func (o Argon2OptionsPtrOutput) Time() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Argon2Options) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Time
+	}).(pulumi.IntPtrOutput)
+}
+
+type BcryptOptions struct {
+	// The bcrypt cost, from 4 to 16. Defaults to 10.
+	Cost *int `pulumi:"cost"`
+}
+
+// BcryptOptionsInput is an input type that accepts BcryptOptionsArgs and BcryptOptionsOutput values.
+// You can construct a concrete instance of `BcryptOptionsInput` via:
+//
+//	BcryptOptionsArgs{...}
+type BcryptOptionsInput interface {
+	pulumi.Input
+
+	ToBcryptOptionsOutput() BcryptOptionsOutput
+	ToBcryptOptionsOutputWithContext(context.Context) BcryptOptionsOutput
+}
+
+type BcryptOptionsArgs struct {
+	// The bcrypt cost, from 4 to 16. Defaults to 10.
+	Cost pulumi.IntPtrInput `pulumi:"cost"`
+}
+
+func (BcryptOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BcryptOptions)(nil)).Elem()
+}
+
+func (i BcryptOptionsArgs) ToBcryptOptionsOutput() BcryptOptionsOutput {
+	return i.ToBcryptOptionsOutputWithContext(context.Background())
+}
+
+func (i BcryptOptionsArgs) ToBcryptOptionsOutputWithContext(ctx context.Context) BcryptOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BcryptOptionsOutput)
+}
+
+func (i BcryptOptionsArgs) ToBcryptOptionsPtrOutput() BcryptOptionsPtrOutput {
+	return i.ToBcryptOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i BcryptOptionsArgs) ToBcryptOptionsPtrOutputWithContext(ctx context.Context) BcryptOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BcryptOptionsOutput).ToBcryptOptionsPtrOutputWithContext(ctx)
+}
+
+// BcryptOptionsPtrInput is an input type that accepts BcryptOptionsArgs, BcryptOptionsPtr and BcryptOptionsPtrOutput values.
+// You can construct a concrete instance of `BcryptOptionsPtrInput` via:
+//
+//	        BcryptOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type BcryptOptionsPtrInput interface {
+	pulumi.Input
+
+	ToBcryptOptionsPtrOutput() BcryptOptionsPtrOutput
+	ToBcryptOptionsPtrOutputWithContext(context.Context) BcryptOptionsPtrOutput
+}
+
+type bcryptOptionsPtrType BcryptOptionsArgs
+
+func BcryptOptionsPtr(v *BcryptOptionsArgs) BcryptOptionsPtrInput {
+	return (*bcryptOptionsPtrType)(v)
+}
+
+func (*bcryptOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**BcryptOptions)(nil)).Elem()
+}
+
+func (i *bcryptOptionsPtrType) ToBcryptOptionsPtrOutput() BcryptOptionsPtrOutput {
+	return i.ToBcryptOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *bcryptOptionsPtrType) ToBcryptOptionsPtrOutputWithContext(ctx context.Context) BcryptOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BcryptOptionsPtrOutput)
+}
+
+type BcryptOptionsOutput struct{ *pulumi.OutputState }
+
+func (BcryptOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BcryptOptions)(nil)).Elem()
+}
+
+func (o BcryptOptionsOutput) ToBcryptOptionsOutput() BcryptOptionsOutput {
+	return o
+}
+
+func (o BcryptOptionsOutput) ToBcryptOptionsOutputWithContext(ctx context.Context) BcryptOptionsOutput {
+	return o
+}
+
+func (o BcryptOptionsOutput) ToBcryptOptionsPtrOutput() BcryptOptionsPtrOutput {
+	return o.ToBcryptOptionsPtrOutputWithContext(context.Background())
+}
+
+func (o BcryptOptionsOutput) ToBcryptOptionsPtrOutputWithContext(ctx context.Context) BcryptOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v BcryptOptions) *BcryptOptions {
+		return &v
+	}).(BcryptOptionsPtrOutput)
+}
+
+// The bcrypt cost, from 4 to 16. Defaults to 10.
+func (o BcryptOptionsOutput) Cost() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v BcryptOptions) *int { return v.Cost }).(pulumi.IntPtrOutput)
+}
+
+type BcryptOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (BcryptOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**BcryptOptions)(nil)).Elem()
+}
+
+func (o BcryptOptionsPtrOutput) ToBcryptOptionsPtrOutput() BcryptOptionsPtrOutput {
+	return o
+}
+
+func (o BcryptOptionsPtrOutput) ToBcryptOptionsPtrOutputWithContext(ctx context.Context) BcryptOptionsPtrOutput {
+	return o
+}
+
+func (o BcryptOptionsPtrOutput) Elem() BcryptOptionsOutput {
+	return o.ApplyT(func(v *BcryptOptions) BcryptOptions {
+		if v != nil {
+			return *v
+		}
+		var ret BcryptOptions
+		return ret
+	}).(BcryptOptionsOutput)
+}
+
+// The bcrypt cost, from 4 to 16. Defaults to 10.
+func (o BcryptOptionsPtrOutput) Cost() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *BcryptOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Cost
+	}).(pulumi.IntPtrOutput)
+}
+
+type BundleVariant struct {
+	// The characters the variant is made of. Defaults to letters and digits.
+	Charset *string `pulumi:"charset"`
+	// How many characters the variant has. Defaults to 32.
+	Length *int `pulumi:"length"`
+}
+
+// BundleVariantInput is an input type that accepts BundleVariantArgs and BundleVariantOutput values.
+// You can construct a concrete instance of `BundleVariantInput` via:
+//
+//	BundleVariantArgs{...}
+type BundleVariantInput interface {
+	pulumi.Input
+
+	ToBundleVariantOutput() BundleVariantOutput
+	ToBundleVariantOutputWithContext(context.Context) BundleVariantOutput
+}
+
+type BundleVariantArgs struct {
+	// The characters the variant is made of. Defaults to letters and digits.
+	Charset pulumi.StringPtrInput `pulumi:"charset"`
+	// How many characters the variant has. Defaults to 32.
+	Length pulumi.IntPtrInput `pulumi:"length"`
+}
+
+func (BundleVariantArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*BundleVariant)(nil)).Elem()
+}
+
+func (i BundleVariantArgs) ToBundleVariantOutput() BundleVariantOutput {
+	return i.ToBundleVariantOutputWithContext(context.Background())
+}
+
+func (i BundleVariantArgs) ToBundleVariantOutputWithContext(ctx context.Context) BundleVariantOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BundleVariantOutput)
+}
+
+// BundleVariantMapInput is an input type that accepts BundleVariantMap and BundleVariantMapOutput values.
+// You can construct a concrete instance of `BundleVariantMapInput` via:
+//
+//	BundleVariantMap{ "key": BundleVariantArgs{...} }
+type BundleVariantMapInput interface {
+	pulumi.Input
+
+	ToBundleVariantMapOutput() BundleVariantMapOutput
+	ToBundleVariantMapOutputWithContext(context.Context) BundleVariantMapOutput
+}
+
+type BundleVariantMap map[string]BundleVariantInput
+
+func (BundleVariantMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]BundleVariant)(nil)).Elem()
+}
+
+func (i BundleVariantMap) ToBundleVariantMapOutput() BundleVariantMapOutput {
+	return i.ToBundleVariantMapOutputWithContext(context.Background())
+}
+
+func (i BundleVariantMap) ToBundleVariantMapOutputWithContext(ctx context.Context) BundleVariantMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(BundleVariantMapOutput)
+}
+
+type BundleVariantOutput struct{ *pulumi.OutputState }
+
+func (BundleVariantOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*BundleVariant)(nil)).Elem()
+}
+
+func (o BundleVariantOutput) ToBundleVariantOutput() BundleVariantOutput {
+	return o
+}
+
+func (o BundleVariantOutput) ToBundleVariantOutputWithContext(ctx context.Context) BundleVariantOutput {
+	return o
+}
+
+// The characters the variant is made of. Defaults to letters and digits.
+func (o BundleVariantOutput) Charset() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v BundleVariant) *string { return v.Charset }).(pulumi.StringPtrOutput)
+}
+
+// How many characters the variant has. Defaults to 32.
+func (o BundleVariantOutput) Length() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v BundleVariant) *int { return v.Length }).(pulumi.IntPtrOutput)
+}
+
+type BundleVariantMapOutput struct{ *pulumi.OutputState }
+
+func (BundleVariantMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]BundleVariant)(nil)).Elem()
+}
+
+func (o BundleVariantMapOutput) ToBundleVariantMapOutput() BundleVariantMapOutput {
+	return o
+}
+
+func (o BundleVariantMapOutput) ToBundleVariantMapOutputWithContext(ctx context.Context) BundleVariantMapOutput {
+	return o
+}
+
+func (o BundleVariantMapOutput) MapIndex(k pulumi.StringInput) BundleVariantOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) BundleVariant {
+		return vs[0].(map[string]BundleVariant)[vs[1].(string)]
+	}).(BundleVariantOutput)
+}
+
+type CertificateSubject struct {
+	// The common name (CN) of the subject.
+	CommonName string `pulumi:"commonName"`
+	// The organization (O) of the subject.
+	Organization *string `pulumi:"organization"`
+}
+
+// CertificateSubjectInput is an input type that accepts CertificateSubjectArgs and CertificateSubjectOutput values.
+// You can construct a concrete instance of `CertificateSubjectInput` via:
+//
+//	CertificateSubjectArgs{...}
+type CertificateSubjectInput interface {
+	pulumi.Input
+
+	ToCertificateSubjectOutput() CertificateSubjectOutput
+	ToCertificateSubjectOutputWithContext(context.Context) CertificateSubjectOutput
+}
+
+type CertificateSubjectArgs struct {
+	// The common name (CN) of the subject.
+	CommonName pulumi.StringInput `pulumi:"commonName"`
+	// The organization (O) of the subject.
+	Organization pulumi.StringPtrInput `pulumi:"organization"`
+}
+
+func (CertificateSubjectArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*CertificateSubject)(nil)).Elem()
+}
+
+func (i CertificateSubjectArgs) ToCertificateSubjectOutput() CertificateSubjectOutput {
+	return i.ToCertificateSubjectOutputWithContext(context.Background())
+}
+
+func (i CertificateSubjectArgs) ToCertificateSubjectOutputWithContext(ctx context.Context) CertificateSubjectOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(CertificateSubjectOutput)
+}
+
+type CertificateSubjectOutput struct{ *pulumi.OutputState }
+
+func (CertificateSubjectOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*CertificateSubject)(nil)).Elem()
+}
+
+func (o CertificateSubjectOutput) ToCertificateSubjectOutput() CertificateSubjectOutput {
+	return o
+}
+
+func (o CertificateSubjectOutput) ToCertificateSubjectOutputWithContext(ctx context.Context) CertificateSubjectOutput {
+	return o
+}
+
+// The common name (CN) of the subject.
+func (o CertificateSubjectOutput) CommonName() pulumi.StringOutput {
+	return o.ApplyT(func(v CertificateSubject) string { return v.CommonName }).(pulumi.StringOutput)
+}
+
+// The organization (O) of the subject.
+func (o CertificateSubjectOutput) Organization() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v CertificateSubject) *string { return v.Organization }).(pulumi.StringPtrOutput)
+}
+
+type Generator struct {
+	// The characters a generated value is made of. Defaults to letters and digits.
+	Charset *string `pulumi:"charset"`
+	// How values are generated.
+	Kind GeneratorKind `pulumi:"kind"`
+	// How many characters a generated value has. Defaults to 32.
+	Length *int `pulumi:"length"`
+	// The most characters a petname may have, separators included.
+	MaxLength *int `pulumi:"maxLength"`
+	// Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+	Seed *string `pulumi:"seed"`
+	// What the words of a petname are joined with. Defaults to `-`.
+	Separator *string `pulumi:"separator"`
+	// How many words a petname has. Defaults to 2.
+	Words *int `pulumi:"words"`
+}
+
+// GeneratorInput is an input type that accepts GeneratorArgs and GeneratorOutput values.
+// You can construct a concrete instance of `GeneratorInput` via:
+//
+//	GeneratorArgs{...}
+type GeneratorInput interface {
+	pulumi.Input
+
+	ToGeneratorOutput() GeneratorOutput
+	ToGeneratorOutputWithContext(context.Context) GeneratorOutput
+}
+
+type GeneratorArgs struct {
+	// The characters a generated value is made of. Defaults to letters and digits.
+	Charset pulumi.StringPtrInput `pulumi:"charset"`
+	// How values are generated.
+	Kind GeneratorKindInput `pulumi:"kind"`
+	// How many characters a generated value has. Defaults to 32.
+	Length pulumi.IntPtrInput `pulumi:"length"`
+	// The most characters a petname may have, separators included.
+	MaxLength pulumi.IntPtrInput `pulumi:"maxLength"`
+	// Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+	Seed pulumi.StringPtrInput `pulumi:"seed"`
+	// What the words of a petname are joined with. Defaults to `-`.
+	Separator pulumi.StringPtrInput `pulumi:"separator"`
+	// How many words a petname has. Defaults to 2.
+	Words pulumi.IntPtrInput `pulumi:"words"`
+}
+
+func (GeneratorArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Generator)(nil)).Elem()
+}
+
+func (i GeneratorArgs) ToGeneratorOutput() GeneratorOutput {
+	return i.ToGeneratorOutputWithContext(context.Background())
+}
+
+func (i GeneratorArgs) ToGeneratorOutputWithContext(ctx context.Context) GeneratorOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GeneratorOutput)
+}
+
+func (i GeneratorArgs) ToGeneratorPtrOutput() GeneratorPtrOutput {
+	return i.ToGeneratorPtrOutputWithContext(context.Background())
+}
+
+func (i GeneratorArgs) ToGeneratorPtrOutputWithContext(ctx context.Context) GeneratorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GeneratorOutput).ToGeneratorPtrOutputWithContext(ctx)
+}
+
+// GeneratorPtrInput is an input type that accepts GeneratorArgs, GeneratorPtr and GeneratorPtrOutput values.
+// You can construct a concrete instance of `GeneratorPtrInput` via:
+//
+//	        GeneratorArgs{...}
+//
+//	or:
+//
+//	        nil
+type GeneratorPtrInput interface {
+	pulumi.Input
+
+	ToGeneratorPtrOutput() GeneratorPtrOutput
+	ToGeneratorPtrOutputWithContext(context.Context) GeneratorPtrOutput
+}
+
+type generatorPtrType GeneratorArgs
+
+func GeneratorPtr(v *GeneratorArgs) GeneratorPtrInput {
+	return (*generatorPtrType)(v)
+}
+
+func (*generatorPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Generator)(nil)).Elem()
+}
+
+func (i *generatorPtrType) ToGeneratorPtrOutput() GeneratorPtrOutput {
+	return i.ToGeneratorPtrOutputWithContext(context.Background())
+}
+
+func (i *generatorPtrType) ToGeneratorPtrOutputWithContext(ctx context.Context) GeneratorPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(GeneratorPtrOutput)
+}
+
+type GeneratorOutput struct{ *pulumi.OutputState }
+
+func (GeneratorOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Generator)(nil)).Elem()
+}
+
+func (o GeneratorOutput) ToGeneratorOutput() GeneratorOutput {
+	return o
+}
+
+func (o GeneratorOutput) ToGeneratorOutputWithContext(ctx context.Context) GeneratorOutput {
+	return o
+}
+
+func (o GeneratorOutput) ToGeneratorPtrOutput() GeneratorPtrOutput {
+	return o.ToGeneratorPtrOutputWithContext(context.Background())
+}
+
+func (o GeneratorOutput) ToGeneratorPtrOutputWithContext(ctx context.Context) GeneratorPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Generator) *Generator {
+		return &v
+	}).(GeneratorPtrOutput)
+}
+
+// The characters a generated value is made of. Defaults to letters and digits.
+func (o GeneratorOutput) Charset() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Generator) *string { return v.Charset }).(pulumi.StringPtrOutput)
+}
+
+// How values are generated.
+func (o GeneratorOutput) Kind() GeneratorKindOutput {
+	return o.ApplyT(func(v Generator) GeneratorKind { return v.Kind }).(GeneratorKindOutput)
+}
+
+// How many characters a generated value has. Defaults to 32.
+func (o GeneratorOutput) Length() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Generator) *int { return v.Length }).(pulumi.IntPtrOutput)
+}
+
+// The most characters a petname may have, separators included.
+func (o GeneratorOutput) MaxLength() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Generator) *int { return v.MaxLength }).(pulumi.IntPtrOutput)
+}
+
+// Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+func (o GeneratorOutput) Seed() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Generator) *string { return v.Seed }).(pulumi.StringPtrOutput)
+}
+
+// What the words of a petname are joined with. Defaults to `-`.
+func (o GeneratorOutput) Separator() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Generator) *string { return v.Separator }).(pulumi.StringPtrOutput)
+}
+
+// How many words a petname has. Defaults to 2.
+func (o GeneratorOutput) Words() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Generator) *int { return v.Words }).(pulumi.IntPtrOutput)
+}
+
+type GeneratorPtrOutput struct{ *pulumi.OutputState }
+
+func (GeneratorPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Generator)(nil)).Elem()
+}
+
+func (o GeneratorPtrOutput) ToGeneratorPtrOutput() GeneratorPtrOutput {
+	return o
+}
+
+func (o GeneratorPtrOutput) ToGeneratorPtrOutputWithContext(ctx context.Context) GeneratorPtrOutput {
+	return o
+}
+
+func (o GeneratorPtrOutput) Elem() GeneratorOutput {
+	return o.ApplyT(func(v *Generator) Generator {
+		if v != nil {
+			return *v
+		}
+		var ret Generator
+		return ret
+	}).(GeneratorOutput)
+}
+
+// The characters a generated value is made of. Defaults to letters and digits.
+func (o GeneratorPtrOutput) Charset() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Generator) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Charset
+	}).(pulumi.StringPtrOutput)
+}
+
+// How values are generated.
+func (o GeneratorPtrOutput) Kind() GeneratorKindPtrOutput {
+	return o.ApplyT(func(v *Generator) *GeneratorKind {
+		if v == nil {
+			return nil
+		}
+		return &v.Kind
+	}).(GeneratorKindPtrOutput)
+}
+
+// How many characters a generated value has. Defaults to 32.
+func (o GeneratorPtrOutput) Length() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Generator) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Length
+	}).(pulumi.IntPtrOutput)
+}
+
+// The most characters a petname may have, separators included.
+func (o GeneratorPtrOutput) MaxLength() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Generator) *int {
+		if v == nil {
+			return nil
+		}
+		return v.MaxLength
+	}).(pulumi.IntPtrOutput)
+}
+
+// Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+func (o GeneratorPtrOutput) Seed() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Generator) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Seed
+	}).(pulumi.StringPtrOutput)
+}
+
+// What the words of a petname are joined with. Defaults to `-`.
+func (o GeneratorPtrOutput) Separator() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Generator) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Separator
+	}).(pulumi.StringPtrOutput)
+}
+
+// How many words a petname has. Defaults to 2.
+func (o GeneratorPtrOutput) Words() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *Generator) *int {
+		if v == nil {
+			return nil
+		}
+		return v.Words
+	}).(pulumi.IntPtrOutput)
+}
+
+type HashOptions struct {
+	// Compute `argon2Hash`, an argon2id hash.
+	Argon2 *Argon2Options `pulumi:"argon2"`
+	// Compute `bcryptHash`.
+	Bcrypt *BcryptOptions `pulumi:"bcrypt"`
+	// Compute `scryptHash`.
+	Scrypt *ScryptOptions `pulumi:"scrypt"`
+}
+
+// HashOptionsInput is an input type that accepts HashOptionsArgs and HashOptionsOutput values.
+// You can construct a concrete instance of `HashOptionsInput` via:
+//
+//	HashOptionsArgs{...}
+type HashOptionsInput interface {
+	pulumi.Input
+
+	ToHashOptionsOutput() HashOptionsOutput
+	ToHashOptionsOutputWithContext(context.Context) HashOptionsOutput
+}
+
+type HashOptionsArgs struct {
+	// Compute `argon2Hash`, an argon2id hash.
+	Argon2 Argon2OptionsPtrInput `pulumi:"argon2"`
+	// Compute `bcryptHash`.
+	Bcrypt BcryptOptionsPtrInput `pulumi:"bcrypt"`
+	// Compute `scryptHash`.
+	Scrypt ScryptOptionsPtrInput `pulumi:"scrypt"`
+}
+
+func (HashOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*HashOptions)(nil)).Elem()
+}
+
+func (i HashOptionsArgs) ToHashOptionsOutput() HashOptionsOutput {
+	return i.ToHashOptionsOutputWithContext(context.Background())
+}
+
+func (i HashOptionsArgs) ToHashOptionsOutputWithContext(ctx context.Context) HashOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HashOptionsOutput)
+}
+
+func (i HashOptionsArgs) ToHashOptionsPtrOutput() HashOptionsPtrOutput {
+	return i.ToHashOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i HashOptionsArgs) ToHashOptionsPtrOutputWithContext(ctx context.Context) HashOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HashOptionsOutput).ToHashOptionsPtrOutputWithContext(ctx)
+}
+
+// HashOptionsPtrInput is an input type that accepts HashOptionsArgs, HashOptionsPtr and HashOptionsPtrOutput values.
+// You can construct a concrete instance of `HashOptionsPtrInput` via:
+//
+//	        HashOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type HashOptionsPtrInput interface {
+	pulumi.Input
+
+	ToHashOptionsPtrOutput() HashOptionsPtrOutput
+	ToHashOptionsPtrOutputWithContext(context.Context) HashOptionsPtrOutput
+}
+
+type hashOptionsPtrType HashOptionsArgs
+
+func HashOptionsPtr(v *HashOptionsArgs) HashOptionsPtrInput {
+	return (*hashOptionsPtrType)(v)
+}
+
+func (*hashOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**HashOptions)(nil)).Elem()
+}
+
+func (i *hashOptionsPtrType) ToHashOptionsPtrOutput() HashOptionsPtrOutput {
+	return i.ToHashOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *hashOptionsPtrType) ToHashOptionsPtrOutputWithContext(ctx context.Context) HashOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(HashOptionsPtrOutput)
+}
+
+type HashOptionsOutput struct{ *pulumi.OutputState }
+
+func (HashOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*HashOptions)(nil)).Elem()
+}
+
+func (o HashOptionsOutput) ToHashOptionsOutput() HashOptionsOutput {
+	return o
+}
+
+func (o HashOptionsOutput) ToHashOptionsOutputWithContext(ctx context.Context) HashOptionsOutput {
+	return o
+}
+
+func (o HashOptionsOutput) ToHashOptionsPtrOutput() HashOptionsPtrOutput {
+	return o.ToHashOptionsPtrOutputWithContext(context.Background())
+}
+
+func (o HashOptionsOutput) ToHashOptionsPtrOutputWithContext(ctx context.Context) HashOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v HashOptions) *HashOptions {
+		return &v
+	}).(HashOptionsPtrOutput)
+}
+
+// Compute `argon2Hash`, an argon2id hash.
+func (o HashOptionsOutput) Argon2() Argon2OptionsPtrOutput {
+	return o.ApplyT(func(v HashOptions) *Argon2Options { return v.Argon2 }).(Argon2OptionsPtrOutput)
+}
+
+// Compute `bcryptHash`.
+func (o HashOptionsOutput) Bcrypt() BcryptOptionsPtrOutput {
+	return o.ApplyT(func(v HashOptions) *BcryptOptions { return v.Bcrypt }).(BcryptOptionsPtrOutput)
+}
+
+// Compute `scryptHash`.
+func (o HashOptionsOutput) Scrypt() ScryptOptionsPtrOutput {
+	return o.ApplyT(func(v HashOptions) *ScryptOptions { return v.Scrypt }).(ScryptOptionsPtrOutput)
+}
+
+type HashOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (HashOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**HashOptions)(nil)).Elem()
+}
+
+func (o HashOptionsPtrOutput) ToHashOptionsPtrOutput() HashOptionsPtrOutput {
+	return o
+}
+
+func (o HashOptionsPtrOutput) ToHashOptionsPtrOutputWithContext(ctx context.Context) HashOptionsPtrOutput {
+	return o
+}
+
+func (o HashOptionsPtrOutput) Elem() HashOptionsOutput {
+	return o.ApplyT(func(v *HashOptions) HashOptions {
+		if v != nil {
+			return *v
+		}
+		var ret HashOptions
+		return ret
+	}).(HashOptionsOutput)
+}
+
+// Compute `argon2Hash`, an argon2id hash.
+func (o HashOptionsPtrOutput) Argon2() Argon2OptionsPtrOutput {
+	return o.ApplyT(func(v *HashOptions) *Argon2Options {
+		if v == nil {
+			return nil
+		}
+		return v.Argon2
+	}).(Argon2OptionsPtrOutput)
+}
+
+// Compute `bcryptHash`.
+func (o HashOptionsPtrOutput) Bcrypt() BcryptOptionsPtrOutput {
+	return o.ApplyT(func(v *HashOptions) *BcryptOptions {
+		if v == nil {
+			return nil
+		}
+		return v.Bcrypt
+	}).(BcryptOptionsPtrOutput)
+}
+
+// Compute `scryptHash`.
+func (o HashOptionsPtrOutput) Scrypt() ScryptOptionsPtrOutput {
+	return o.ApplyT(func(v *HashOptions) *ScryptOptions {
+		if v == nil {
+			return nil
+		}
+		return v.Scrypt
+	}).(ScryptOptionsPtrOutput)
+}
+
+type PairField struct {
+	// How to generate the value to pin. A new value is only generated when the pair rotates.
+	Generator *Generator `pulumi:"generator"`
+	// The value to pin. Exactly one of `value` and `generator` is set.
+	Value *string `pulumi:"value"`
+}
+
+// PairFieldInput is an input type that accepts PairFieldArgs and PairFieldOutput values.
+// You can construct a concrete instance of `PairFieldInput` via:
+//
+//	PairFieldArgs{...}
+type PairFieldInput interface {
+	pulumi.Input
+
+	ToPairFieldOutput() PairFieldOutput
+	ToPairFieldOutputWithContext(context.Context) PairFieldOutput
+}
+
+type PairFieldArgs struct {
+	// How to generate the value to pin. A new value is only generated when the pair rotates.
+	Generator GeneratorPtrInput `pulumi:"generator"`
+	// The value to pin. Exactly one of `value` and `generator` is set.
+	Value pulumi.StringPtrInput `pulumi:"value"`
+}
+
+func (PairFieldArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*PairField)(nil)).Elem()
+}
+
+func (i PairFieldArgs) ToPairFieldOutput() PairFieldOutput {
+	return i.ToPairFieldOutputWithContext(context.Background())
+}
+
+func (i PairFieldArgs) ToPairFieldOutputWithContext(ctx context.Context) PairFieldOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PairFieldOutput)
+}
+
+// PairFieldMapInput is an input type that accepts PairFieldMap and PairFieldMapOutput values.
+// You can construct a concrete instance of `PairFieldMapInput` via:
+//
+//	PairFieldMap{ "key": PairFieldArgs{...} }
+type PairFieldMapInput interface {
+	pulumi.Input
+
+	ToPairFieldMapOutput() PairFieldMapOutput
+	ToPairFieldMapOutputWithContext(context.Context) PairFieldMapOutput
+}
+
+type PairFieldMap map[string]PairFieldInput
+
+func (PairFieldMap) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]PairField)(nil)).Elem()
+}
+
+func (i PairFieldMap) ToPairFieldMapOutput() PairFieldMapOutput {
+	return i.ToPairFieldMapOutputWithContext(context.Background())
+}
+
+func (i PairFieldMap) ToPairFieldMapOutputWithContext(ctx context.Context) PairFieldMapOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(PairFieldMapOutput)
+}
+
+type PairFieldOutput struct{ *pulumi.OutputState }
+
+func (PairFieldOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*PairField)(nil)).Elem()
+}
+
+func (o PairFieldOutput) ToPairFieldOutput() PairFieldOutput {
+	return o
+}
+
+func (o PairFieldOutput) ToPairFieldOutputWithContext(ctx context.Context) PairFieldOutput {
+	return o
+}
+
+// How to generate the value to pin. A new value is only generated when the pair rotates.
+func (o PairFieldOutput) Generator() GeneratorPtrOutput {
+	return o.ApplyT(func(v PairField) *Generator { return v.Generator }).(GeneratorPtrOutput)
+}
+
+// The value to pin. Exactly one of `value` and `generator` is set.
+func (o PairFieldOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v PairField) *string { return v.Value }).(pulumi.StringPtrOutput)
+}
+
+type PairFieldMapOutput struct{ *pulumi.OutputState }
+
+func (PairFieldMapOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*map[string]PairField)(nil)).Elem()
+}
+
+func (o PairFieldMapOutput) ToPairFieldMapOutput() PairFieldMapOutput {
+	return o
+}
+
+func (o PairFieldMapOutput) ToPairFieldMapOutputWithContext(ctx context.Context) PairFieldMapOutput {
+	return o
+}
+
+func (o PairFieldMapOutput) MapIndex(k pulumi.StringInput) PairFieldOutput {
+	return pulumi.All(o, k).ApplyT(func(vs []interface{}) PairField {
+		return vs[0].(map[string]PairField)[vs[1].(string)]
+	}).(PairFieldOutput)
+}
+
+type ScryptOptions struct {
+	// The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+	LogN *int `pulumi:"logN"`
+	// The parallelization. Defaults to 1.
+	P *int `pulumi:"p"`
+	// The block size. Defaults to 8.
+	R *int `pulumi:"r"`
+}
+
+// ScryptOptionsInput is an input type that accepts ScryptOptionsArgs and ScryptOptionsOutput values.
+// You can construct a concrete instance of `ScryptOptionsInput` via:
+//
+//	ScryptOptionsArgs{...}
+type ScryptOptionsInput interface {
+	pulumi.Input
+
+	ToScryptOptionsOutput() ScryptOptionsOutput
+	ToScryptOptionsOutputWithContext(context.Context) ScryptOptionsOutput
+}
+
+type ScryptOptionsArgs struct {
+	// The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+	LogN pulumi.IntPtrInput `pulumi:"logN"`
+	// The parallelization. Defaults to 1.
+	P pulumi.IntPtrInput `pulumi:"p"`
+	// The block size. Defaults to 8.
+	R pulumi.IntPtrInput `pulumi:"r"`
+}
+
+func (ScryptOptionsArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*ScryptOptions)(nil)).Elem()
+}
+
+func (i ScryptOptionsArgs) ToScryptOptionsOutput() ScryptOptionsOutput {
+	return i.ToScryptOptionsOutputWithContext(context.Background())
+}
+
+func (i ScryptOptionsArgs) ToScryptOptionsOutputWithContext(ctx context.Context) ScryptOptionsOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScryptOptionsOutput)
+}
+
+func (i ScryptOptionsArgs) ToScryptOptionsPtrOutput() ScryptOptionsPtrOutput {
+	return i.ToScryptOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i ScryptOptionsArgs) ToScryptOptionsPtrOutputWithContext(ctx context.Context) ScryptOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScryptOptionsOutput).ToScryptOptionsPtrOutputWithContext(ctx)
+}
+
+// ScryptOptionsPtrInput is an input type that accepts ScryptOptionsArgs, ScryptOptionsPtr and ScryptOptionsPtrOutput values.
+// You can construct a concrete instance of `ScryptOptionsPtrInput` via:
+//
+//	        ScryptOptionsArgs{...}
+//
+//	or:
+//
+//	        nil
+type ScryptOptionsPtrInput interface {
+	pulumi.Input
+
+	ToScryptOptionsPtrOutput() ScryptOptionsPtrOutput
+	ToScryptOptionsPtrOutputWithContext(context.Context) ScryptOptionsPtrOutput
+}
+
+type scryptOptionsPtrType ScryptOptionsArgs
+
+func ScryptOptionsPtr(v *ScryptOptionsArgs) ScryptOptionsPtrInput {
+	return (*scryptOptionsPtrType)(v)
+}
+
+func (*scryptOptionsPtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**ScryptOptions)(nil)).Elem()
+}
+
+func (i *scryptOptionsPtrType) ToScryptOptionsPtrOutput() ScryptOptionsPtrOutput {
+	return i.ToScryptOptionsPtrOutputWithContext(context.Background())
+}
+
+func (i *scryptOptionsPtrType) ToScryptOptionsPtrOutputWithContext(ctx context.Context) ScryptOptionsPtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(ScryptOptionsPtrOutput)
+}
+
+type ScryptOptionsOutput struct{ *pulumi.OutputState }
+
+func (ScryptOptionsOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*ScryptOptions)(nil)).Elem()
+}
+
+func (o ScryptOptionsOutput) ToScryptOptionsOutput() ScryptOptionsOutput {
+	return o
+}
+
+func (o ScryptOptionsOutput) ToScryptOptionsOutputWithContext(ctx context.Context) ScryptOptionsOutput {
+	return o
+}
+
+func (o ScryptOptionsOutput) ToScryptOptionsPtrOutput() ScryptOptionsPtrOutput {
+	return o.ToScryptOptionsPtrOutputWithContext(context.Background())
+}
+
+func (o ScryptOptionsOutput) ToScryptOptionsPtrOutputWithContext(ctx context.Context) ScryptOptionsPtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v ScryptOptions) *ScryptOptions {
+		return &v
+	}).(ScryptOptionsPtrOutput)
+}
+
+// The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+func (o ScryptOptionsOutput) LogN() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ScryptOptions) *int { return v.LogN }).(pulumi.IntPtrOutput)
+}
+
+// The parallelization. Defaults to 1.
+func (o ScryptOptionsOutput) P() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ScryptOptions) *int { return v.P }).(pulumi.IntPtrOutput)
+}
+
+// The block size. Defaults to 8.
+func (o ScryptOptionsOutput) R() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v ScryptOptions) *int { return v.R }).(pulumi.IntPtrOutput)
+}
+
+type ScryptOptionsPtrOutput struct{ *pulumi.OutputState }
+
+func (ScryptOptionsPtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**ScryptOptions)(nil)).Elem()
+}
+
+func (o ScryptOptionsPtrOutput) ToScryptOptionsPtrOutput() ScryptOptionsPtrOutput {
+	return o
+}
+
+func (o ScryptOptionsPtrOutput) ToScryptOptionsPtrOutputWithContext(ctx context.Context) ScryptOptionsPtrOutput {
+	return o
+}
+
+func (o ScryptOptionsPtrOutput) Elem() ScryptOptionsOutput {
+	return o.ApplyT(func(v *ScryptOptions) ScryptOptions {
+		if v != nil {
+			return *v
+		}
+		var ret ScryptOptions
+		return ret
+	}).(ScryptOptionsOutput)
+}
+
+// The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+func (o ScryptOptionsPtrOutput) LogN() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ScryptOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.LogN
+	}).(pulumi.IntPtrOutput)
+}
+
+// The parallelization. Defaults to 1.
+func (o ScryptOptionsPtrOutput) P() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ScryptOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.P
+	}).(pulumi.IntPtrOutput)
+}
+
+// The block size. Defaults to 8.
+func (o ScryptOptionsPtrOutput) R() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *ScryptOptions) *int {
+		if v == nil {
+			return nil
+		}
+		return v.R
+	}).(pulumi.IntPtrOutput)
+}
+
+type Source struct {
+	// The program and arguments a `command` source runs.
+	Command []string `pulumi:"command"`
+	// The kind of source.
+	Kind SourceKind `pulumi:"kind"`
+	// The environment variable an `env` source reads.
+	Name *string `pulumi:"name"`
+	// The file a `file` source reads.
+	Path *string `pulumi:"path"`
+}
+
+// SourceInput is an input type that accepts SourceArgs and SourceOutput values.
+// You can construct a concrete instance of `SourceInput` via:
+//
+//	SourceArgs{...}
+type SourceInput interface {
+	pulumi.Input
+
+	ToSourceOutput() SourceOutput
+	ToSourceOutputWithContext(context.Context) SourceOutput
+}
+
+type SourceArgs struct {
+	// The program and arguments a `command` source runs.
+	Command pulumi.StringArrayInput `pulumi:"command"`
+	// The kind of source.
+	Kind SourceKindInput `pulumi:"kind"`
+	// The environment variable an `env` source reads.
+	Name pulumi.StringPtrInput `pulumi:"name"`
+	// The file a `file` source reads.
+	Path pulumi.StringPtrInput `pulumi:"path"`
+}
+
+func (SourceArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Source)(nil)).Elem()
+}
+
+func (i SourceArgs) ToSourceOutput() SourceOutput {
+	return i.ToSourceOutputWithContext(context.Background())
+}
+
+func (i SourceArgs) ToSourceOutputWithContext(ctx context.Context) SourceOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SourceOutput)
+}
+
+func (i SourceArgs) ToSourcePtrOutput() SourcePtrOutput {
+	return i.ToSourcePtrOutputWithContext(context.Background())
+}
+
+func (i SourceArgs) ToSourcePtrOutputWithContext(ctx context.Context) SourcePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SourceOutput).ToSourcePtrOutputWithContext(ctx)
+}
+
+// SourcePtrInput is an input type that accepts SourceArgs, SourcePtr and SourcePtrOutput values.
+// You can construct a concrete instance of `SourcePtrInput` via:
+//
+//	        SourceArgs{...}
+//
+//	or:
+//
+//	        nil
+type SourcePtrInput interface {
+	pulumi.Input
+
+	ToSourcePtrOutput() SourcePtrOutput
+	ToSourcePtrOutputWithContext(context.Context) SourcePtrOutput
+}
+
+type sourcePtrType SourceArgs
+
+func SourcePtr(v *SourceArgs) SourcePtrInput {
+	return (*sourcePtrType)(v)
+}
+
+func (*sourcePtrType) ElementType() reflect.Type {
+	return reflect.TypeOf((**Source)(nil)).Elem()
+}
+
+func (i *sourcePtrType) ToSourcePtrOutput() SourcePtrOutput {
+	return i.ToSourcePtrOutputWithContext(context.Background())
+}
+
+func (i *sourcePtrType) ToSourcePtrOutputWithContext(ctx context.Context) SourcePtrOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SourcePtrOutput)
+}
+
+type SourceOutput struct{ *pulumi.OutputState }
+
+func (SourceOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Source)(nil)).Elem()
+}
+
+func (o SourceOutput) ToSourceOutput() SourceOutput {
+	return o
+}
+
+func (o SourceOutput) ToSourceOutputWithContext(ctx context.Context) SourceOutput {
+	return o
+}
+
+func (o SourceOutput) ToSourcePtrOutput() SourcePtrOutput {
+	return o.ToSourcePtrOutputWithContext(context.Background())
+}
+
+func (o SourceOutput) ToSourcePtrOutputWithContext(ctx context.Context) SourcePtrOutput {
+	return o.ApplyTWithContext(ctx, func(_ context.Context, v Source) *Source {
+		return &v
+	}).(SourcePtrOutput)
+}
+
+// The program and arguments a `command` source runs.
+func (o SourceOutput) Command() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v Source) []string { return v.Command }).(pulumi.StringArrayOutput)
+}
+
+// The kind of source.
+func (o SourceOutput) Kind() SourceKindOutput {
+	return o.ApplyT(func(v Source) SourceKind { return v.Kind }).(SourceKindOutput)
+}
+
+// The environment variable an `env` source reads.
+func (o SourceOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Source) *string { return v.Name }).(pulumi.StringPtrOutput)
+}
+
+// The file a `file` source reads.
+func (o SourceOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Source) *string { return v.Path }).(pulumi.StringPtrOutput)
+}
+
+type SourcePtrOutput struct{ *pulumi.OutputState }
+
+func (SourcePtrOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**Source)(nil)).Elem()
+}
+
+func (o SourcePtrOutput) ToSourcePtrOutput() SourcePtrOutput {
+	return o
+}
+
+func (o SourcePtrOutput) ToSourcePtrOutputWithContext(ctx context.Context) SourcePtrOutput {
+	return o
+}
+
+func (o SourcePtrOutput) Elem() SourceOutput {
+	return o.ApplyT(func(v *Source) Source {
+		if v != nil {
+			return *v
+		}
+		var ret Source
+		return ret
+	}).(SourceOutput)
+}
+
+// The program and arguments a `command` source runs.
+func (o SourcePtrOutput) Command() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *Source) []string {
+		if v == nil {
+			return nil
+		}
+		return v.Command
+	}).(pulumi.StringArrayOutput)
+}
+
+// The kind of source.
+func (o SourcePtrOutput) Kind() SourceKindPtrOutput {
+	return o.ApplyT(func(v *Source) *SourceKind {
+		if v == nil {
+			return nil
+		}
+		return &v.Kind
+	}).(SourceKindPtrOutput)
+}
+
+// The environment variable an `env` source reads.
+func (o SourcePtrOutput) Name() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Source) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Name
+	}).(pulumi.StringPtrOutput)
+}
+
+// The file a `file` source reads.
+func (o SourcePtrOutput) Path() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *Source) *string {
+		if v == nil {
+			return nil
+		}
+		return v.Path
+	}).(pulumi.StringPtrOutput)
+}
+
+type Transform struct {
+	// The transform to apply.
+	Kind TransformKind `pulumi:"kind"`
+	// The length a `truncate` transform keeps.
+	Length *int `pulumi:"length"`
+	// The text a `prefix` or `suffix` transform adds.
+	Value *string `pulumi:"value"`
+}
+
+// Defaults sets the appropriate defaults for Transform
+func (val *Transform) Defaults() *Transform {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Length == nil {
+		length_ := 63
+		tmp.Length = &length_
+	}
+	return &tmp
+}
+
+// TransformInput is an input type that accepts TransformArgs and TransformOutput values.
+// You can construct a concrete instance of `TransformInput` via:
+//
+//	TransformArgs{...}
+type TransformInput interface {
+	pulumi.Input
+
+	ToTransformOutput() TransformOutput
+	ToTransformOutputWithContext(context.Context) TransformOutput
+}
+
+type TransformArgs struct {
+	// The transform to apply.
+	Kind TransformKindInput `pulumi:"kind"`
+	// The length a `truncate` transform keeps.
+	Length pulumi.IntPtrInput `pulumi:"length"`
+	// The text a `prefix` or `suffix` transform adds.
+	Value pulumi.StringPtrInput `pulumi:"value"`
+}
+
+// Defaults sets the appropriate defaults for TransformArgs
+func (val *TransformArgs) Defaults() *TransformArgs {
+	if val == nil {
+		return nil
+	}
+	tmp := *val
+	if tmp.Length == nil {
+		tmp.Length = pulumi.IntPtr(63)
+	}
+	return &tmp
+}
+func (TransformArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*Transform)(nil)).Elem()
+}
+
+func (i TransformArgs) ToTransformOutput() TransformOutput {
+	return i.ToTransformOutputWithContext(context.Background())
+}
+
+func (i TransformArgs) ToTransformOutputWithContext(ctx context.Context) TransformOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TransformOutput)
+}
+
+// TransformArrayInput is an input type that accepts TransformArray and TransformArrayOutput values.
+// You can construct a concrete instance of `TransformArrayInput` via:
+//
+//	TransformArray{ TransformArgs{...} }
+type TransformArrayInput interface {
+	pulumi.Input
+
+	ToTransformArrayOutput() TransformArrayOutput
+	ToTransformArrayOutputWithContext(context.Context) TransformArrayOutput
+}
+
+type TransformArray []TransformInput
+
+func (TransformArray) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Transform)(nil)).Elem()
+}
+
+func (i TransformArray) ToTransformArrayOutput() TransformArrayOutput {
+	return i.ToTransformArrayOutputWithContext(context.Background())
+}
+
+func (i TransformArray) ToTransformArrayOutputWithContext(ctx context.Context) TransformArrayOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(TransformArrayOutput)
+}
+
+type TransformOutput struct{ *pulumi.OutputState }
+
+func (TransformOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*Transform)(nil)).Elem()
+}
+
+func (o TransformOutput) ToTransformOutput() TransformOutput {
+	return o
+}
+
+func (o TransformOutput) ToTransformOutputWithContext(ctx context.Context) TransformOutput {
+	return o
+}
+
+// The transform to apply.
+func (o TransformOutput) Kind() TransformKindOutput {
+	return o.ApplyT(func(v Transform) TransformKind { return v.Kind }).(TransformKindOutput)
+}
+
+// The length a `truncate` transform keeps.
+func (o TransformOutput) Length() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v Transform) *int { return v.Length }).(pulumi.IntPtrOutput)
+}
+
+// The text a `prefix` or `suffix` transform adds.
+func (o TransformOutput) Value() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v Transform) *string { return v.Value }).(pulumi.StringPtrOutput)
+}
+
+type TransformArrayOutput struct{ *pulumi.OutputState }
+
+func (TransformArrayOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((*[]Transform)(nil)).Elem()
+}
+
+func (o TransformArrayOutput) ToTransformArrayOutput() TransformArrayOutput {
+	return o
+}
+
+func (o TransformArrayOutput) ToTransformArrayOutputWithContext(ctx context.Context) TransformArrayOutput {
+	return o
+}
+
+func (o TransformArrayOutput) Index(i pulumi.IntInput) TransformOutput {
+	return pulumi.All(o, i).ApplyT(func(vs []interface{}) Transform {
+		return vs[0].([]Transform)[vs[1].(int)]
+	}).(TransformOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*Argon2OptionsInput)(nil)).Elem(), Argon2OptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*Argon2OptionsPtrInput)(nil)).Elem(), Argon2OptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BcryptOptionsInput)(nil)).Elem(), BcryptOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BcryptOptionsPtrInput)(nil)).Elem(), BcryptOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BundleVariantInput)(nil)).Elem(), BundleVariantArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*BundleVariantMapInput)(nil)).Elem(), BundleVariantMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*CertificateSubjectInput)(nil)).Elem(), CertificateSubjectArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GeneratorInput)(nil)).Elem(), GeneratorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*GeneratorPtrInput)(nil)).Elem(), GeneratorArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HashOptionsInput)(nil)).Elem(), HashOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*HashOptionsPtrInput)(nil)).Elem(), HashOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PairFieldInput)(nil)).Elem(), PairFieldArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*PairFieldMapInput)(nil)).Elem(), PairFieldMap{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ScryptOptionsInput)(nil)).Elem(), ScryptOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*ScryptOptionsPtrInput)(nil)).Elem(), ScryptOptionsArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SourceInput)(nil)).Elem(), SourceArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*SourcePtrInput)(nil)).Elem(), SourceArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TransformInput)(nil)).Elem(), TransformArgs{})
+	pulumi.RegisterInputType(reflect.TypeOf((*TransformArrayInput)(nil)).Elem(), TransformArray{})
+	pulumi.RegisterOutputType(Argon2OptionsOutput{})
+	pulumi.RegisterOutputType(Argon2OptionsPtrOutput{})
+	pulumi.RegisterOutputType(BcryptOptionsOutput{})
+	pulumi.RegisterOutputType(BcryptOptionsPtrOutput{})
+	pulumi.RegisterOutputType(BundleVariantOutput{})
+	pulumi.RegisterOutputType(BundleVariantMapOutput{})
+	pulumi.RegisterOutputType(CertificateSubjectOutput{})
+	pulumi.RegisterOutputType(GeneratorOutput{})
+	pulumi.RegisterOutputType(GeneratorPtrOutput{})
+	pulumi.RegisterOutputType(HashOptionsOutput{})
+	pulumi.RegisterOutputType(HashOptionsPtrOutput{})
+	pulumi.RegisterOutputType(PairFieldOutput{})
+	pulumi.RegisterOutputType(PairFieldMapOutput{})
+	pulumi.RegisterOutputType(ScryptOptionsOutput{})
+	pulumi.RegisterOutputType(ScryptOptionsPtrOutput{})
+	pulumi.RegisterOutputType(SourceOutput{})
+	pulumi.RegisterOutputType(SourcePtrOutput{})
+	pulumi.RegisterOutputType(TransformOutput{})
+	pulumi.RegisterOutputType(TransformArrayOutput{})
+}
@@ -0,0 +1,153 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package statefulString
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A string that stays pinned until one of its triggers changes, published with its triggers to the provider's shared store so that other stacks can read it with `getSharedStatefulString`.
+type SharedStatefulString struct {
+	pulumi.CustomResourceState
+
+	// The name of the record in the shared store. Changing it replaces the resource.
+	Key pulumi.StringOutput `pulumi:"key"`
+	// Identifies this resource as the owner of the record in the shared store.
+	OwnerId pulumi.StringOutput `pulumi:"ownerId"`
+	// The string to pin. Changes are only picked up when a trigger changes.
+	String pulumi.StringOutput `pulumi:"string"`
+	// Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+	Triggers pulumi.MapOutput `pulumi:"triggers"`
+}
+
+// NewSharedStatefulString registers a new resource with the given unique name, arguments, and options.
+func NewSharedStatefulString(ctx *pulumi.Context,
+	name string, args *SharedStatefulStringArgs, opts ...pulumi.ResourceOption) (*SharedStatefulString, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Key == nil {
+		return nil, errors.New("invalid value for required argument 'Key'")
+	}
+	if args.String == nil {
+		return nil, errors.New("invalid value for required argument 'String'")
+	}
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource SharedStatefulString
+	err := ctx.RegisterResource("statefulString:index:SharedStatefulString", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetSharedStatefulString gets an existing SharedStatefulString resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetSharedStatefulString(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *SharedStatefulStringState, opts ...pulumi.ResourceOption) (*SharedStatefulString, error) {
+	var resource SharedStatefulString
+	err := ctx.ReadResource("statefulString:index:SharedStatefulString", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering SharedStatefulString resources.
+type sharedStatefulStringState struct {
+}
+
+type SharedStatefulStringState struct {
+}
+
+func (SharedStatefulStringState) ElementType() reflect.Type {
+	return reflect.TypeOf((*sharedStatefulStringState)(nil)).Elem()
+}
+
+type sharedStatefulStringArgs struct {
+	// The name of the record in the shared store. Changing it replaces the resource.
+	Key string `pulumi:"key"`
+	// The string to pin. Changes are only picked up when a trigger changes.
+	String string `pulumi:"string"`
+	// Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+	Triggers map[string]interface{} `pulumi:"triggers"`
+}
+
+// The set of arguments for constructing a SharedStatefulString resource.
+type SharedStatefulStringArgs struct {
+	// The name of the record in the shared store. Changing it replaces the resource.
+	Key pulumi.StringInput
+	// The string to pin. Changes are only picked up when a trigger changes.
+	String pulumi.StringInput
+	// Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+	Triggers pulumi.MapInput
+}
+
+func (SharedStatefulStringArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*sharedStatefulStringArgs)(nil)).Elem()
+}
+
+type SharedStatefulStringInput interface {
+	pulumi.Input
+
+	ToSharedStatefulStringOutput() SharedStatefulStringOutput
+	ToSharedStatefulStringOutputWithContext(ctx context.Context) SharedStatefulStringOutput
+}
+
+func (*SharedStatefulString) ElementType() reflect.Type {
+	return reflect.TypeOf((**SharedStatefulString)(nil)).Elem()
+}
+
+func (i *SharedStatefulString) ToSharedStatefulStringOutput() SharedStatefulStringOutput {
+	return i.ToSharedStatefulStringOutputWithContext(context.Background())
+}
+
+func (i *SharedStatefulString) ToSharedStatefulStringOutputWithContext(ctx context.Context) SharedStatefulStringOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(SharedStatefulStringOutput)
+}
+
+type SharedStatefulStringOutput struct{ *pulumi.OutputState }
+
+func (SharedStatefulStringOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**SharedStatefulString)(nil)).Elem()
+}
+
+func (o SharedStatefulStringOutput) ToSharedStatefulStringOutput() SharedStatefulStringOutput {
+	return o
+}
+
+func (o SharedStatefulStringOutput) ToSharedStatefulStringOutputWithContext(ctx context.Context) SharedStatefulStringOutput {
+	return o
+}
+
+// The name of the record in the shared store. Changing it replaces the resource.
+func (o SharedStatefulStringOutput) Key() pulumi.StringOutput {
+	return o.ApplyT(func(v *SharedStatefulString) pulumi.StringOutput { return v.Key }).(pulumi.StringOutput)
+}
+
+// Identifies this resource as the owner of the record in the shared store.
+func (o SharedStatefulStringOutput) OwnerId() pulumi.StringOutput {
+	return o.ApplyT(func(v *SharedStatefulString) pulumi.StringOutput { return v.OwnerId }).(pulumi.StringOutput)
+}
+
+// The string to pin. Changes are only picked up when a trigger changes.
+func (o SharedStatefulStringOutput) String() pulumi.StringOutput {
+	return o.ApplyT(func(v *SharedStatefulString) pulumi.StringOutput { return v.String }).(pulumi.StringOutput)
+}
+
+// Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+func (o SharedStatefulStringOutput) Triggers() pulumi.MapOutput {
+	return o.ApplyT(func(v *SharedStatefulString) pulumi.MapOutput { return v.Triggers }).(pulumi.MapOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*SharedStatefulStringInput)(nil)).Elem(), &SharedStatefulString{})
+	pulumi.RegisterOutputType(SharedStatefulStringOutput{})
+}
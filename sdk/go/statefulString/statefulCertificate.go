@@ -0,0 +1,285 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package statefulString
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A self-signed or locally-CA-signed certificate that stays pinned until its contents or triggers change, or until it is within `renewBefore` of expiring.
+type StatefulCertificate struct {
+	pulumi.CustomResourceState
+
+	// The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+	CaCertPem pulumi.StringPtrOutput `pulumi:"caCertPem"`
+	// The PEM private key of the CA that signs the certificate.
+	CaPrivateKeyPem pulumi.StringPtrOutput `pulumi:"caPrivateKeyPem"`
+	// The certificate in PEM format.
+	CertPem pulumi.StringOutput `pulumi:"certPem"`
+	// The DNS names the certificate is valid for.
+	DnsNames pulumi.StringArrayOutput `pulumi:"dnsNames"`
+	// The curve of an ECDSA key. Defaults to `P256`.
+	EcdsaCurve ECDSACurvePtrOutput `pulumi:"ecdsaCurve"`
+	// The hex SHA-256 digest of the DER certificate.
+	FingerprintSha256 pulumi.StringOutput `pulumi:"fingerprintSha256"`
+	// The IP addresses the certificate is valid for.
+	IpAddresses pulumi.StringArrayOutput `pulumi:"ipAddresses"`
+	// Whether the certificate can sign other certificates, for use as a local CA.
+	IsCa pulumi.BoolPtrOutput `pulumi:"isCa"`
+	// The kind of key to generate. Defaults to `ecdsa`.
+	KeyAlgorithm KeyAlgorithmPtrOutput `pulumi:"keyAlgorithm"`
+	// The RFC 3339 time at which the certificate expires.
+	NotAfter pulumi.StringOutput `pulumi:"notAfter"`
+	// The RFC 3339 time from which the certificate is valid.
+	NotBefore pulumi.StringOutput `pulumi:"notBefore"`
+	// The private key of the certificate in PKCS #8 PEM format.
+	PrivateKeyPem pulumi.StringOutput `pulumi:"privateKeyPem"`
+	// How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+	RenewBefore pulumi.StringPtrOutput `pulumi:"renewBefore"`
+	// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+	RsaBits pulumi.IntPtrOutput `pulumi:"rsaBits"`
+	// The subject of the certificate.
+	Subject CertificateSubjectOutput `pulumi:"subject"`
+	// Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+	Triggers pulumi.MapOutput `pulumi:"triggers"`
+	// How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+	ValidFor pulumi.StringPtrOutput `pulumi:"validFor"`
+}
+
+// NewStatefulCertificate registers a new resource with the given unique name, arguments, and options.
+func NewStatefulCertificate(ctx *pulumi.Context,
+	name string, args *StatefulCertificateArgs, opts ...pulumi.ResourceOption) (*StatefulCertificate, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Subject == nil {
+		return nil, errors.New("invalid value for required argument 'Subject'")
+	}
+	if args.CaPrivateKeyPem != nil {
+		args.CaPrivateKeyPem = pulumi.ToSecret(args.CaPrivateKeyPem).(pulumi.StringPtrInput)
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"caPrivateKeyPem",
+		"privateKeyPem",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource StatefulCertificate
+	err := ctx.RegisterResource("statefulString:index:StatefulCertificate", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetStatefulCertificate gets an existing StatefulCertificate resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetStatefulCertificate(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *StatefulCertificateState, opts ...pulumi.ResourceOption) (*StatefulCertificate, error) {
+	var resource StatefulCertificate
+	err := ctx.ReadResource("statefulString:index:StatefulCertificate", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering StatefulCertificate resources.
+type statefulCertificateState struct {
+}
+
+type StatefulCertificateState struct {
+}
+
+func (StatefulCertificateState) ElementType() reflect.Type {
+	return reflect.TypeOf((*statefulCertificateState)(nil)).Elem()
+}
+
+type statefulCertificateArgs struct {
+	// The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+	CaCertPem *string `pulumi:"caCertPem"`
+	// The PEM private key of the CA that signs the certificate.
+	CaPrivateKeyPem *string `pulumi:"caPrivateKeyPem"`
+	// The DNS names the certificate is valid for.
+	DnsNames []string `pulumi:"dnsNames"`
+	// The curve of an ECDSA key. Defaults to `P256`.
+	EcdsaCurve *ECDSACurve `pulumi:"ecdsaCurve"`
+	// The IP addresses the certificate is valid for.
+	IpAddresses []string `pulumi:"ipAddresses"`
+	// Whether the certificate can sign other certificates, for use as a local CA.
+	IsCa *bool `pulumi:"isCa"`
+	// The kind of key to generate. Defaults to `ecdsa`.
+	KeyAlgorithm *KeyAlgorithm `pulumi:"keyAlgorithm"`
+	// How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+	RenewBefore *string `pulumi:"renewBefore"`
+	// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+	RsaBits *int `pulumi:"rsaBits"`
+	// The subject of the certificate.
+	Subject CertificateSubject `pulumi:"subject"`
+	// Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+	Triggers map[string]interface{} `pulumi:"triggers"`
+	// How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+	ValidFor *string `pulumi:"validFor"`
+}
+
+// The set of arguments for constructing a StatefulCertificate resource.
+type StatefulCertificateArgs struct {
+	// The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+	CaCertPem pulumi.StringPtrInput
+	// The PEM private key of the CA that signs the certificate.
+	CaPrivateKeyPem pulumi.StringPtrInput
+	// The DNS names the certificate is valid for.
+	DnsNames pulumi.StringArrayInput
+	// The curve of an ECDSA key. Defaults to `P256`.
+	EcdsaCurve ECDSACurvePtrInput
+	// The IP addresses the certificate is valid for.
+	IpAddresses pulumi.StringArrayInput
+	// Whether the certificate can sign other certificates, for use as a local CA.
+	IsCa pulumi.BoolPtrInput
+	// The kind of key to generate. Defaults to `ecdsa`.
+	KeyAlgorithm KeyAlgorithmPtrInput
+	// How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+	RenewBefore pulumi.StringPtrInput
+	// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+	RsaBits pulumi.IntPtrInput
+	// The subject of the certificate.
+	Subject CertificateSubjectInput
+	// Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+	Triggers pulumi.MapInput
+	// How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+	ValidFor pulumi.StringPtrInput
+}
+
+func (StatefulCertificateArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*statefulCertificateArgs)(nil)).Elem()
+}
+
+type StatefulCertificateInput interface {
+	pulumi.Input
+
+	ToStatefulCertificateOutput() StatefulCertificateOutput
+	ToStatefulCertificateOutputWithContext(ctx context.Context) StatefulCertificateOutput
+}
+
+func (*StatefulCertificate) ElementType() reflect.Type {
+	return reflect.TypeOf((**StatefulCertificate)(nil)).Elem()
+}
+
+func (i *StatefulCertificate) ToStatefulCertificateOutput() StatefulCertificateOutput {
+	return i.ToStatefulCertificateOutputWithContext(context.Background())
+}
+
+func (i *StatefulCertificate) ToStatefulCertificateOutputWithContext(ctx context.Context) StatefulCertificateOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StatefulCertificateOutput)
+}
+
+type StatefulCertificateOutput struct{ *pulumi.OutputState }
+
+func (StatefulCertificateOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**StatefulCertificate)(nil)).Elem()
+}
+
+func (o StatefulCertificateOutput) ToStatefulCertificateOutput() StatefulCertificateOutput {
+	return o
+}
+
+func (o StatefulCertificateOutput) ToStatefulCertificateOutputWithContext(ctx context.Context) StatefulCertificateOutput {
+	return o
+}
+
+// The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+func (o StatefulCertificateOutput) CaCertPem() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringPtrOutput { return v.CaCertPem }).(pulumi.StringPtrOutput)
+}
+
+// The PEM private key of the CA that signs the certificate.
+func (o StatefulCertificateOutput) CaPrivateKeyPem() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringPtrOutput { return v.CaPrivateKeyPem }).(pulumi.StringPtrOutput)
+}
+
+// The certificate in PEM format.
+func (o StatefulCertificateOutput) CertPem() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringOutput { return v.CertPem }).(pulumi.StringOutput)
+}
+
+// The DNS names the certificate is valid for.
+func (o StatefulCertificateOutput) DnsNames() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringArrayOutput { return v.DnsNames }).(pulumi.StringArrayOutput)
+}
+
+// The curve of an ECDSA key. Defaults to `P256`.
+func (o StatefulCertificateOutput) EcdsaCurve() ECDSACurvePtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) ECDSACurvePtrOutput { return v.EcdsaCurve }).(ECDSACurvePtrOutput)
+}
+
+// The hex SHA-256 digest of the DER certificate.
+func (o StatefulCertificateOutput) FingerprintSha256() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringOutput { return v.FingerprintSha256 }).(pulumi.StringOutput)
+}
+
+// The IP addresses the certificate is valid for.
+func (o StatefulCertificateOutput) IpAddresses() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringArrayOutput { return v.IpAddresses }).(pulumi.StringArrayOutput)
+}
+
+// Whether the certificate can sign other certificates, for use as a local CA.
+func (o StatefulCertificateOutput) IsCa() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.BoolPtrOutput { return v.IsCa }).(pulumi.BoolPtrOutput)
+}
+
+// The kind of key to generate. Defaults to `ecdsa`.
+func (o StatefulCertificateOutput) KeyAlgorithm() KeyAlgorithmPtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) KeyAlgorithmPtrOutput { return v.KeyAlgorithm }).(KeyAlgorithmPtrOutput)
+}
+
+// The RFC 3339 time at which the certificate expires.
+func (o StatefulCertificateOutput) NotAfter() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringOutput { return v.NotAfter }).(pulumi.StringOutput)
+}
+
+// The RFC 3339 time from which the certificate is valid.
+func (o StatefulCertificateOutput) NotBefore() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringOutput { return v.NotBefore }).(pulumi.StringOutput)
+}
+
+// The private key of the certificate in PKCS #8 PEM format.
+func (o StatefulCertificateOutput) PrivateKeyPem() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringOutput { return v.PrivateKeyPem }).(pulumi.StringOutput)
+}
+
+// How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+func (o StatefulCertificateOutput) RenewBefore() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringPtrOutput { return v.RenewBefore }).(pulumi.StringPtrOutput)
+}
+
+// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+func (o StatefulCertificateOutput) RsaBits() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.IntPtrOutput { return v.RsaBits }).(pulumi.IntPtrOutput)
+}
+
+// The subject of the certificate.
+func (o StatefulCertificateOutput) Subject() CertificateSubjectOutput {
+	return o.ApplyT(func(v *StatefulCertificate) CertificateSubjectOutput { return v.Subject }).(CertificateSubjectOutput)
+}
+
+// Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+func (o StatefulCertificateOutput) Triggers() pulumi.MapOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.MapOutput { return v.Triggers }).(pulumi.MapOutput)
+}
+
+// How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+func (o StatefulCertificateOutput) ValidFor() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulCertificate) pulumi.StringPtrOutput { return v.ValidFor }).(pulumi.StringPtrOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*StatefulCertificateInput)(nil)).Elem(), &StatefulCertificate{})
+	pulumi.RegisterOutputType(StatefulCertificateOutput{})
+}
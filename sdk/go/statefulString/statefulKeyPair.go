@@ -0,0 +1,194 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package statefulString
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// A generated key pair that stays pinned until one of its triggers changes. Changes to the key settings are only applied by the next rotation.
+type StatefulKeyPair struct {
+	pulumi.CustomResourceState
+
+	// The kind of key to generate.
+	Algorithm KeyAlgorithmOutput `pulumi:"algorithm"`
+	// The curve of an ECDSA key. Defaults to `P256`.
+	EcdsaCurve ECDSACurvePtrOutput `pulumi:"ecdsaCurve"`
+	// The OpenSSH SHA256 fingerprint of the public key.
+	FingerprintSha256 pulumi.StringOutput `pulumi:"fingerprintSha256"`
+	// The private key in OpenSSH format.
+	PrivateKeyOpenssh pulumi.StringOutput `pulumi:"privateKeyOpenssh"`
+	// The private key in PKCS #8 PEM format.
+	PrivateKeyPem pulumi.StringOutput `pulumi:"privateKeyPem"`
+	// The public key in OpenSSH authorized_keys format.
+	PublicKeyOpenssh pulumi.StringOutput `pulumi:"publicKeyOpenssh"`
+	// The public key in PKIX PEM format.
+	PublicKeyPem pulumi.StringOutput `pulumi:"publicKeyPem"`
+	// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+	RsaBits pulumi.IntPtrOutput `pulumi:"rsaBits"`
+	// Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+	Triggers pulumi.MapOutput `pulumi:"triggers"`
+}
+
+// NewStatefulKeyPair registers a new resource with the given unique name, arguments, and options.
+func NewStatefulKeyPair(ctx *pulumi.Context,
+	name string, args *StatefulKeyPairArgs, opts ...pulumi.ResourceOption) (*StatefulKeyPair, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Algorithm == nil {
+		return nil, errors.New("invalid value for required argument 'Algorithm'")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"privateKeyOpenssh",
+		"privateKeyPem",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource StatefulKeyPair
+	err := ctx.RegisterResource("statefulString:index:StatefulKeyPair", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetStatefulKeyPair gets an existing StatefulKeyPair resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetStatefulKeyPair(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *StatefulKeyPairState, opts ...pulumi.ResourceOption) (*StatefulKeyPair, error) {
+	var resource StatefulKeyPair
+	err := ctx.ReadResource("statefulString:index:StatefulKeyPair", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering StatefulKeyPair resources.
+type statefulKeyPairState struct {
+}
+
+type StatefulKeyPairState struct {
+}
+
+func (StatefulKeyPairState) ElementType() reflect.Type {
+	return reflect.TypeOf((*statefulKeyPairState)(nil)).Elem()
+}
+
+type statefulKeyPairArgs struct {
+	// The kind of key to generate.
+	Algorithm KeyAlgorithm `pulumi:"algorithm"`
+	// The curve of an ECDSA key. Defaults to `P256`.
+	EcdsaCurve *ECDSACurve `pulumi:"ecdsaCurve"`
+	// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+	RsaBits *int `pulumi:"rsaBits"`
+	// Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+	Triggers map[string]interface{} `pulumi:"triggers"`
+}
+
+// The set of arguments for constructing a StatefulKeyPair resource.
+type StatefulKeyPairArgs struct {
+	// The kind of key to generate.
+	Algorithm KeyAlgorithmInput
+	// The curve of an ECDSA key. Defaults to `P256`.
+	EcdsaCurve ECDSACurvePtrInput
+	// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+	RsaBits pulumi.IntPtrInput
+	// Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+	Triggers pulumi.MapInput
+}
+
+func (StatefulKeyPairArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*statefulKeyPairArgs)(nil)).Elem()
+}
+
+type StatefulKeyPairInput interface {
+	pulumi.Input
+
+	ToStatefulKeyPairOutput() StatefulKeyPairOutput
+	ToStatefulKeyPairOutputWithContext(ctx context.Context) StatefulKeyPairOutput
+}
+
+func (*StatefulKeyPair) ElementType() reflect.Type {
+	return reflect.TypeOf((**StatefulKeyPair)(nil)).Elem()
+}
+
+func (i *StatefulKeyPair) ToStatefulKeyPairOutput() StatefulKeyPairOutput {
+	return i.ToStatefulKeyPairOutputWithContext(context.Background())
+}
+
+func (i *StatefulKeyPair) ToStatefulKeyPairOutputWithContext(ctx context.Context) StatefulKeyPairOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StatefulKeyPairOutput)
+}
+
+type StatefulKeyPairOutput struct{ *pulumi.OutputState }
+
+func (StatefulKeyPairOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**StatefulKeyPair)(nil)).Elem()
+}
+
+func (o StatefulKeyPairOutput) ToStatefulKeyPairOutput() StatefulKeyPairOutput {
+	return o
+}
+
+func (o StatefulKeyPairOutput) ToStatefulKeyPairOutputWithContext(ctx context.Context) StatefulKeyPairOutput {
+	return o
+}
+
+// The kind of key to generate.
+func (o StatefulKeyPairOutput) Algorithm() KeyAlgorithmOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) KeyAlgorithmOutput { return v.Algorithm }).(KeyAlgorithmOutput)
+}
+
+// The curve of an ECDSA key. Defaults to `P256`.
+func (o StatefulKeyPairOutput) EcdsaCurve() ECDSACurvePtrOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) ECDSACurvePtrOutput { return v.EcdsaCurve }).(ECDSACurvePtrOutput)
+}
+
+// The OpenSSH SHA256 fingerprint of the public key.
+func (o StatefulKeyPairOutput) FingerprintSha256() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) pulumi.StringOutput { return v.FingerprintSha256 }).(pulumi.StringOutput)
+}
+
+// The private key in OpenSSH format.
+func (o StatefulKeyPairOutput) PrivateKeyOpenssh() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) pulumi.StringOutput { return v.PrivateKeyOpenssh }).(pulumi.StringOutput)
+}
+
+// The private key in PKCS #8 PEM format.
+func (o StatefulKeyPairOutput) PrivateKeyPem() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) pulumi.StringOutput { return v.PrivateKeyPem }).(pulumi.StringOutput)
+}
+
+// The public key in OpenSSH authorized_keys format.
+func (o StatefulKeyPairOutput) PublicKeyOpenssh() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) pulumi.StringOutput { return v.PublicKeyOpenssh }).(pulumi.StringOutput)
+}
+
+// The public key in PKIX PEM format.
+func (o StatefulKeyPairOutput) PublicKeyPem() pulumi.StringOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) pulumi.StringOutput { return v.PublicKeyPem }).(pulumi.StringOutput)
+}
+
+// The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+func (o StatefulKeyPairOutput) RsaBits() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) pulumi.IntPtrOutput { return v.RsaBits }).(pulumi.IntPtrOutput)
+}
+
+// Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+func (o StatefulKeyPairOutput) Triggers() pulumi.MapOutput {
+	return o.ApplyT(func(v *StatefulKeyPair) pulumi.MapOutput { return v.Triggers }).(pulumi.MapOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*StatefulKeyPairInput)(nil)).Elem(), &StatefulKeyPair{})
+	pulumi.RegisterOutputType(StatefulKeyPairOutput{})
+}
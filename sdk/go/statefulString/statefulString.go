@@ -7,31 +7,115 @@ import (
 	"context"
 	"reflect"
 
-	"errors"
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
 	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
-	"internal"
 )
 
+// A string that stays pinned to its first value until one of its triggers changes, at which point the latest `string` input is pinned instead.
 type StatefulString struct {
 	pulumi.CustomResourceState
 
-	String   pulumi.StringOutput    `pulumi:"string"`
-	Triggers pulumi.StringMapOutput `pulumi:"triggers"`
+	// The only values the string may take before it is pinned.
+	AllowedValues pulumi.StringArrayOutput `pulumi:"allowedValues"`
+	// The argon2id hash of the pinned string in PHC format, when `hashes.argon2` is set.
+	Argon2Hash pulumi.StringPtrOutput `pulumi:"argon2Hash"`
+	// The bcrypt hash of the pinned string, when `hashes.bcrypt` is set.
+	BcryptHash pulumi.StringPtrOutput `pulumi:"bcryptHash"`
+	// The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+	Charset pulumi.StringPtrOutput `pulumi:"charset"`
+	// The pinned string.
+	Current pulumi.StringPtrOutput `pulumi:"current"`
+	// The value of the source as of the last refresh or rotation.
+	CurrentSourceValue pulumi.StringPtrOutput `pulumi:"currentSourceValue"`
+	// The latest `string` input, even when it has not been pinned.
+	DesiredString pulumi.StringPtrOutput `pulumi:"desiredString"`
+	// How long a pinned string lives, such as `720h`.
+	ExpiresAfter pulumi.StringPtrOutput `pulumi:"expiresAfter"`
+	// The RFC 3339 time at which the pinned string expires.
+	ExpiresAt pulumi.StringPtrOutput `pulumi:"expiresAt"`
+	// A nonce: any change to it pins the string regardless of triggers.
+	ForceRotate pulumi.StringPtrOutput `pulumi:"forceRotate"`
+	// How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+	Generator GeneratorPtrOutput `pulumi:"generator"`
+	// The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+	Hashes HashOptionsPtrOutput `pulumi:"hashes"`
+	// Whether the latest `string` input differs from the pinned string.
+	IsStale pulumi.BoolPtrOutput `pulumi:"isStale"`
+	// A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+	JsonSchema pulumi.StringPtrOutput `pulumi:"jsonSchema"`
+	// The most characters the string may have before it is pinned.
+	MaxLength pulumi.IntPtrOutput `pulumi:"maxLength"`
+	// The fewest characters the string may have before it is pinned.
+	MinLength pulumi.IntPtrOutput `pulumi:"minLength"`
+	// What happens once the pinned string has expired. Defaults to `warn`.
+	OnExpiry ExpiryPolicyPtrOutput `pulumi:"onExpiry"`
+	// The pinned string decoded as JSON. Only set when `jsonSchema` is given.
+	Parsed pulumi.MapOutput `pulumi:"parsed"`
+	// A regular expression the string must match before it is pinned.
+	Pattern pulumi.StringPtrOutput `pulumi:"pattern"`
+	// The string staged by a rotation and waiting to be promoted.
+	Pending pulumi.StringPtrOutput `pulumi:"pending"`
+	// How many updates have happened since `pending` was staged.
+	PendingUpdates pulumi.IntPtrOutput `pulumi:"pendingUpdates"`
+	// The string replaced by the last rotation, until `previousRetainedUntil`.
+	Previous pulumi.StringPtrOutput `pulumi:"previous"`
+	// The RFC 3339 time until which `previous` is kept.
+	PreviousRetainedUntil pulumi.StringPtrOutput `pulumi:"previousRetainedUntil"`
+	// How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+	PreviousRetention pulumi.StringPtrOutput `pulumi:"previousRetention"`
+	// A nonce: any change to it pins the pending string.
+	Promote pulumi.StringPtrOutput `pulumi:"promote"`
+	// Pin the pending string automatically on this many updates after it was staged.
+	PromoteAfterUpdates pulumi.IntPtrOutput `pulumi:"promoteAfterUpdates"`
+	// The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+	RestoreFrom pulumi.StringPtrOutput `pulumi:"restoreFrom"`
+	// The pinned string after `transforms` have been applied.
+	Result pulumi.StringPtrOutput `pulumi:"result"`
+	// Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+	RetainOnDelete pulumi.BoolPtrOutput `pulumi:"retainOnDelete"`
+	// How many strings have been pinned, starting from 1.
+	Revision pulumi.IntPtrOutput `pulumi:"revision"`
+	// Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+	RotateOnDrift pulumi.BoolPtrOutput `pulumi:"rotateOnDrift"`
+	// A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+	RotationGroup pulumi.StringPtrOutput `pulumi:"rotationGroup"`
+	// The trigger value of the rotation group as of the last update.
+	RotationGroupTrigger pulumi.StringPtrOutput `pulumi:"rotationGroupTrigger"`
+	// Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+	RotationMode RotationModePtrOutput `pulumi:"rotationMode"`
+	// The scrypt hash of the pinned string in PHC format, when `hashes.scrypt` is set.
+	ScryptHash pulumi.StringPtrOutput `pulumi:"scryptHash"`
+	// Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+	SensitiveTriggers pulumi.StringArrayOutput `pulumi:"sensitiveTriggers"`
+	// Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+	Source SourcePtrOutput `pulumi:"source"`
+	// Whether the source differed from the pinned string at the last refresh.
+	SourceDrift pulumi.BoolPtrOutput `pulumi:"sourceDrift"`
+	// Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+	Staged pulumi.BoolPtrOutput `pulumi:"staged"`
+	// The string to pin. Changes are only picked up when the string rotates.
+	String pulumi.StringPtrOutput `pulumi:"string"`
+	// Transforms applied in order to the pinned string to compute `result`.
+	Transforms TransformArrayOutput `pulumi:"transforms"`
+	// Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+	Triggers pulumi.MapOutput `pulumi:"triggers"`
+	// How long before expiry every diff starts to warn, such as `72h`.
+	WarnBefore pulumi.StringPtrOutput `pulumi:"warnBefore"`
 }
 
 // NewStatefulString registers a new resource with the given unique name, arguments, and options.
 func NewStatefulString(ctx *pulumi.Context,
 	name string, args *StatefulStringArgs, opts ...pulumi.ResourceOption) (*StatefulString, error) {
 	if args == nil {
-		return nil, errors.New("missing one or more required arguments")
+		args = &StatefulStringArgs{}
 	}
 
-	if args.String == nil {
-		return nil, errors.New("invalid value for required argument 'String'")
-	}
-	if args.Triggers == nil {
-		return nil, errors.New("invalid value for required argument 'Triggers'")
-	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"argon2Hash",
+		"bcryptHash",
+		"scryptHash",
+	})
+	opts = append(opts, secrets)
 	opts = internal.PkgResourceDefaultOpts(opts)
 	var resource StatefulString
 	err := ctx.RegisterResource("statefulString:index:StatefulString", name, args, &resource, opts...)
@@ -65,14 +149,114 @@ func (StatefulStringState) ElementType() reflect.Type {
 }
 
 type statefulStringArgs struct {
-	String   string            `pulumi:"string"`
-	Triggers map[string]string `pulumi:"triggers"`
+	// The only values the string may take before it is pinned.
+	AllowedValues []string `pulumi:"allowedValues"`
+	// The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+	Charset *string `pulumi:"charset"`
+	// How long a pinned string lives, such as `720h`.
+	ExpiresAfter *string `pulumi:"expiresAfter"`
+	// A nonce: any change to it pins the string regardless of triggers.
+	ForceRotate *string `pulumi:"forceRotate"`
+	// How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+	Generator *Generator `pulumi:"generator"`
+	// The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+	Hashes *HashOptions `pulumi:"hashes"`
+	// A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+	JsonSchema *string `pulumi:"jsonSchema"`
+	// The most characters the string may have before it is pinned.
+	MaxLength *int `pulumi:"maxLength"`
+	// The fewest characters the string may have before it is pinned.
+	MinLength *int `pulumi:"minLength"`
+	// What happens once the pinned string has expired. Defaults to `warn`.
+	OnExpiry *ExpiryPolicy `pulumi:"onExpiry"`
+	// A regular expression the string must match before it is pinned.
+	Pattern *string `pulumi:"pattern"`
+	// How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+	PreviousRetention *string `pulumi:"previousRetention"`
+	// A nonce: any change to it pins the pending string.
+	Promote *string `pulumi:"promote"`
+	// Pin the pending string automatically on this many updates after it was staged.
+	PromoteAfterUpdates *int `pulumi:"promoteAfterUpdates"`
+	// The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+	RestoreFrom *string `pulumi:"restoreFrom"`
+	// Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+	RetainOnDelete *bool `pulumi:"retainOnDelete"`
+	// Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+	RotateOnDrift *bool `pulumi:"rotateOnDrift"`
+	// A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+	RotationGroup *string `pulumi:"rotationGroup"`
+	// Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+	RotationMode *RotationMode `pulumi:"rotationMode"`
+	// Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+	SensitiveTriggers []string `pulumi:"sensitiveTriggers"`
+	// Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+	Source *Source `pulumi:"source"`
+	// Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+	Staged *bool `pulumi:"staged"`
+	// The string to pin. Changes are only picked up when the string rotates.
+	String *string `pulumi:"string"`
+	// Transforms applied in order to the pinned string to compute `result`.
+	Transforms []Transform `pulumi:"transforms"`
+	// Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+	Triggers map[string]interface{} `pulumi:"triggers"`
+	// How long before expiry every diff starts to warn, such as `72h`.
+	WarnBefore *string `pulumi:"warnBefore"`
 }
 
 // The set of arguments for constructing a StatefulString resource.
 type StatefulStringArgs struct {
-	String   pulumi.StringInput
-	Triggers pulumi.StringMapInput
+	// The only values the string may take before it is pinned.
+	AllowedValues pulumi.StringArrayInput
+	// The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+	Charset pulumi.StringPtrInput
+	// How long a pinned string lives, such as `720h`.
+	ExpiresAfter pulumi.StringPtrInput
+	// A nonce: any change to it pins the string regardless of triggers.
+	ForceRotate pulumi.StringPtrInput
+	// How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+	Generator GeneratorPtrInput
+	// The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+	Hashes HashOptionsPtrInput
+	// A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+	JsonSchema pulumi.StringPtrInput
+	// The most characters the string may have before it is pinned.
+	MaxLength pulumi.IntPtrInput
+	// The fewest characters the string may have before it is pinned.
+	MinLength pulumi.IntPtrInput
+	// What happens once the pinned string has expired. Defaults to `warn`.
+	OnExpiry ExpiryPolicyPtrInput
+	// A regular expression the string must match before it is pinned.
+	Pattern pulumi.StringPtrInput
+	// How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+	PreviousRetention pulumi.StringPtrInput
+	// A nonce: any change to it pins the pending string.
+	Promote pulumi.StringPtrInput
+	// Pin the pending string automatically on this many updates after it was staged.
+	PromoteAfterUpdates pulumi.IntPtrInput
+	// The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+	RestoreFrom pulumi.StringPtrInput
+	// Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+	RetainOnDelete pulumi.BoolPtrInput
+	// Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+	RotateOnDrift pulumi.BoolPtrInput
+	// A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+	RotationGroup pulumi.StringPtrInput
+	// Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+	RotationMode RotationModePtrInput
+	// Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+	SensitiveTriggers pulumi.StringArrayInput
+	// Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+	Source SourcePtrInput
+	// Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+	Staged pulumi.BoolPtrInput
+	// The string to pin. Changes are only picked up when the string rotates.
+	String pulumi.StringPtrInput
+	// Transforms applied in order to the pinned string to compute `result`.
+	Transforms TransformArrayInput
+	// Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+	Triggers pulumi.MapInput
+	// How long before expiry every diff starts to warn, such as `72h`.
+	WarnBefore pulumi.StringPtrInput
 }
 
 func (StatefulStringArgs) ElementType() reflect.Type {
@@ -112,12 +296,219 @@ func (o StatefulStringOutput) ToStatefulStringOutputWithContext(ctx context.Cont
 	return o
 }
 
-func (o StatefulStringOutput) String() pulumi.StringOutput {
-	return o.ApplyT(func(v *StatefulString) pulumi.StringOutput { return v.String }).(pulumi.StringOutput)
+// The only values the string may take before it is pinned.
+func (o StatefulStringOutput) AllowedValues() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringArrayOutput { return v.AllowedValues }).(pulumi.StringArrayOutput)
+}
+
+// The argon2id hash of the pinned string in PHC format, when `hashes.argon2` is set.
+func (o StatefulStringOutput) Argon2Hash() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Argon2Hash }).(pulumi.StringPtrOutput)
+}
+
+// The bcrypt hash of the pinned string, when `hashes.bcrypt` is set.
+func (o StatefulStringOutput) BcryptHash() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.BcryptHash }).(pulumi.StringPtrOutput)
+}
+
+// The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+func (o StatefulStringOutput) Charset() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Charset }).(pulumi.StringPtrOutput)
+}
+
+// The pinned string.
+func (o StatefulStringOutput) Current() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Current }).(pulumi.StringPtrOutput)
+}
+
+// The value of the source as of the last refresh or rotation.
+func (o StatefulStringOutput) CurrentSourceValue() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.CurrentSourceValue }).(pulumi.StringPtrOutput)
+}
+
+// The latest `string` input, even when it has not been pinned.
+func (o StatefulStringOutput) DesiredString() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.DesiredString }).(pulumi.StringPtrOutput)
+}
+
+// How long a pinned string lives, such as `720h`.
+func (o StatefulStringOutput) ExpiresAfter() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.ExpiresAfter }).(pulumi.StringPtrOutput)
+}
+
+// The RFC 3339 time at which the pinned string expires.
+func (o StatefulStringOutput) ExpiresAt() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.ExpiresAt }).(pulumi.StringPtrOutput)
+}
+
+// A nonce: any change to it pins the string regardless of triggers.
+func (o StatefulStringOutput) ForceRotate() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.ForceRotate }).(pulumi.StringPtrOutput)
+}
+
+// How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+func (o StatefulStringOutput) Generator() GeneratorPtrOutput {
+	return o.ApplyT(func(v *StatefulString) GeneratorPtrOutput { return v.Generator }).(GeneratorPtrOutput)
+}
+
+// The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+func (o StatefulStringOutput) Hashes() HashOptionsPtrOutput {
+	return o.ApplyT(func(v *StatefulString) HashOptionsPtrOutput { return v.Hashes }).(HashOptionsPtrOutput)
+}
+
+// Whether the latest `string` input differs from the pinned string.
+func (o StatefulStringOutput) IsStale() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.BoolPtrOutput { return v.IsStale }).(pulumi.BoolPtrOutput)
+}
+
+// A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+func (o StatefulStringOutput) JsonSchema() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.JsonSchema }).(pulumi.StringPtrOutput)
+}
+
+// The most characters the string may have before it is pinned.
+func (o StatefulStringOutput) MaxLength() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.IntPtrOutput { return v.MaxLength }).(pulumi.IntPtrOutput)
+}
+
+// The fewest characters the string may have before it is pinned.
+func (o StatefulStringOutput) MinLength() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.IntPtrOutput { return v.MinLength }).(pulumi.IntPtrOutput)
+}
+
+// What happens once the pinned string has expired. Defaults to `warn`.
+func (o StatefulStringOutput) OnExpiry() ExpiryPolicyPtrOutput {
+	return o.ApplyT(func(v *StatefulString) ExpiryPolicyPtrOutput { return v.OnExpiry }).(ExpiryPolicyPtrOutput)
+}
+
+// The pinned string decoded as JSON. Only set when `jsonSchema` is given.
+func (o StatefulStringOutput) Parsed() pulumi.MapOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.MapOutput { return v.Parsed }).(pulumi.MapOutput)
+}
+
+// A regular expression the string must match before it is pinned.
+func (o StatefulStringOutput) Pattern() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Pattern }).(pulumi.StringPtrOutput)
+}
+
+// The string staged by a rotation and waiting to be promoted.
+func (o StatefulStringOutput) Pending() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Pending }).(pulumi.StringPtrOutput)
+}
+
+// How many updates have happened since `pending` was staged.
+func (o StatefulStringOutput) PendingUpdates() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.IntPtrOutput { return v.PendingUpdates }).(pulumi.IntPtrOutput)
+}
+
+// The string replaced by the last rotation, until `previousRetainedUntil`.
+func (o StatefulStringOutput) Previous() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Previous }).(pulumi.StringPtrOutput)
+}
+
+// The RFC 3339 time until which `previous` is kept.
+func (o StatefulStringOutput) PreviousRetainedUntil() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.PreviousRetainedUntil }).(pulumi.StringPtrOutput)
+}
+
+// How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+func (o StatefulStringOutput) PreviousRetention() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.PreviousRetention }).(pulumi.StringPtrOutput)
+}
+
+// A nonce: any change to it pins the pending string.
+func (o StatefulStringOutput) Promote() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Promote }).(pulumi.StringPtrOutput)
+}
+
+// Pin the pending string automatically on this many updates after it was staged.
+func (o StatefulStringOutput) PromoteAfterUpdates() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.IntPtrOutput { return v.PromoteAfterUpdates }).(pulumi.IntPtrOutput)
+}
+
+// The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+func (o StatefulStringOutput) RestoreFrom() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.RestoreFrom }).(pulumi.StringPtrOutput)
+}
+
+// The pinned string after `transforms` have been applied.
+func (o StatefulStringOutput) Result() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.Result }).(pulumi.StringPtrOutput)
+}
+
+// Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+func (o StatefulStringOutput) RetainOnDelete() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.BoolPtrOutput { return v.RetainOnDelete }).(pulumi.BoolPtrOutput)
+}
+
+// How many strings have been pinned, starting from 1.
+func (o StatefulStringOutput) Revision() pulumi.IntPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.IntPtrOutput { return v.Revision }).(pulumi.IntPtrOutput)
+}
+
+// Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+func (o StatefulStringOutput) RotateOnDrift() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.BoolPtrOutput { return v.RotateOnDrift }).(pulumi.BoolPtrOutput)
+}
+
+// A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+func (o StatefulStringOutput) RotationGroup() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.RotationGroup }).(pulumi.StringPtrOutput)
+}
+
+// The trigger value of the rotation group as of the last update.
+func (o StatefulStringOutput) RotationGroupTrigger() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.RotationGroupTrigger }).(pulumi.StringPtrOutput)
+}
+
+// Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+func (o StatefulStringOutput) RotationMode() RotationModePtrOutput {
+	return o.ApplyT(func(v *StatefulString) RotationModePtrOutput { return v.RotationMode }).(RotationModePtrOutput)
+}
+
+// The scrypt hash of the pinned string in PHC format, when `hashes.scrypt` is set.
+func (o StatefulStringOutput) ScryptHash() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.ScryptHash }).(pulumi.StringPtrOutput)
+}
+
+// Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+func (o StatefulStringOutput) SensitiveTriggers() pulumi.StringArrayOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringArrayOutput { return v.SensitiveTriggers }).(pulumi.StringArrayOutput)
+}
+
+// Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+func (o StatefulStringOutput) Source() SourcePtrOutput {
+	return o.ApplyT(func(v *StatefulString) SourcePtrOutput { return v.Source }).(SourcePtrOutput)
+}
+
+// Whether the source differed from the pinned string at the last refresh.
+func (o StatefulStringOutput) SourceDrift() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.BoolPtrOutput { return v.SourceDrift }).(pulumi.BoolPtrOutput)
+}
+
+// Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+func (o StatefulStringOutput) Staged() pulumi.BoolPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.BoolPtrOutput { return v.Staged }).(pulumi.BoolPtrOutput)
+}
+
+// The string to pin. Changes are only picked up when the string rotates.
+func (o StatefulStringOutput) String() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.String }).(pulumi.StringPtrOutput)
+}
+
+// Transforms applied in order to the pinned string to compute `result`.
+func (o StatefulStringOutput) Transforms() TransformArrayOutput {
+	return o.ApplyT(func(v *StatefulString) TransformArrayOutput { return v.Transforms }).(TransformArrayOutput)
+}
+
+// Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+func (o StatefulStringOutput) Triggers() pulumi.MapOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.MapOutput { return v.Triggers }).(pulumi.MapOutput)
 }
 
-func (o StatefulStringOutput) Triggers() pulumi.StringMapOutput {
-	return o.ApplyT(func(v *StatefulString) pulumi.StringMapOutput { return v.Triggers }).(pulumi.StringMapOutput)
+// How long before expiry every diff starts to warn, such as `72h`.
+func (o StatefulStringOutput) WarnBefore() pulumi.StringPtrOutput {
+	return o.ApplyT(func(v *StatefulString) pulumi.StringPtrOutput { return v.WarnBefore }).(pulumi.StringPtrOutput)
 }
 
 func init() {
@@ -0,0 +1,143 @@
+// Code generated by pulumi-language-go DO NOT EDIT.
+// *** WARNING: Do not edit by hand unless you're certain you know what you are doing! ***
+
+package statefulString
+
+import (
+	"context"
+	"reflect"
+
+	"errors"
+	"github.com/pulumi/pulumi-statefulstring/sdk/go/statefulString/internal"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+)
+
+// Two or more named strings, such as a username and a password, that share one set of triggers and always rotate together.
+type StatefulStringPair struct {
+	pulumi.CustomResourceState
+
+	// The fields to pin, by name. At least two are required.
+	Fields PairFieldMapOutput `pulumi:"fields"`
+	// Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+	Triggers pulumi.MapOutput `pulumi:"triggers"`
+	// The pinned value of every field, by field name.
+	Values pulumi.StringMapOutput `pulumi:"values"`
+}
+
+// NewStatefulStringPair registers a new resource with the given unique name, arguments, and options.
+func NewStatefulStringPair(ctx *pulumi.Context,
+	name string, args *StatefulStringPairArgs, opts ...pulumi.ResourceOption) (*StatefulStringPair, error) {
+	if args == nil {
+		return nil, errors.New("missing one or more required arguments")
+	}
+
+	if args.Fields == nil {
+		return nil, errors.New("invalid value for required argument 'Fields'")
+	}
+	secrets := pulumi.AdditionalSecretOutputs([]string{
+		"values",
+	})
+	opts = append(opts, secrets)
+	opts = internal.PkgResourceDefaultOpts(opts)
+	var resource StatefulStringPair
+	err := ctx.RegisterResource("statefulString:index:StatefulStringPair", name, args, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// GetStatefulStringPair gets an existing StatefulStringPair resource's state with the given name, ID, and optional
+// state properties that are used to uniquely qualify the lookup (nil if not required).
+func GetStatefulStringPair(ctx *pulumi.Context,
+	name string, id pulumi.IDInput, state *StatefulStringPairState, opts ...pulumi.ResourceOption) (*StatefulStringPair, error) {
+	var resource StatefulStringPair
+	err := ctx.ReadResource("statefulString:index:StatefulStringPair", name, id, state, &resource, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// Input properties used for looking up and filtering StatefulStringPair resources.
+type statefulStringPairState struct {
+}
+
+type StatefulStringPairState struct {
+}
+
+func (StatefulStringPairState) ElementType() reflect.Type {
+	return reflect.TypeOf((*statefulStringPairState)(nil)).Elem()
+}
+
+type statefulStringPairArgs struct {
+	// The fields to pin, by name. At least two are required.
+	Fields map[string]PairField `pulumi:"fields"`
+	// Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+	Triggers map[string]interface{} `pulumi:"triggers"`
+}
+
+// The set of arguments for constructing a StatefulStringPair resource.
+type StatefulStringPairArgs struct {
+	// The fields to pin, by name. At least two are required.
+	Fields PairFieldMapInput
+	// Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+	Triggers pulumi.MapInput
+}
+
+func (StatefulStringPairArgs) ElementType() reflect.Type {
+	return reflect.TypeOf((*statefulStringPairArgs)(nil)).Elem()
+}
+
+type StatefulStringPairInput interface {
+	pulumi.Input
+
+	ToStatefulStringPairOutput() StatefulStringPairOutput
+	ToStatefulStringPairOutputWithContext(ctx context.Context) StatefulStringPairOutput
+}
+
+func (*StatefulStringPair) ElementType() reflect.Type {
+	return reflect.TypeOf((**StatefulStringPair)(nil)).Elem()
+}
+
+func (i *StatefulStringPair) ToStatefulStringPairOutput() StatefulStringPairOutput {
+	return i.ToStatefulStringPairOutputWithContext(context.Background())
+}
+
+func (i *StatefulStringPair) ToStatefulStringPairOutputWithContext(ctx context.Context) StatefulStringPairOutput {
+	return pulumi.ToOutputWithContext(ctx, i).(StatefulStringPairOutput)
+}
+
+type StatefulStringPairOutput struct{ *pulumi.OutputState }
+
+func (StatefulStringPairOutput) ElementType() reflect.Type {
+	return reflect.TypeOf((**StatefulStringPair)(nil)).Elem()
+}
+
+func (o StatefulStringPairOutput) ToStatefulStringPairOutput() StatefulStringPairOutput {
+	return o
+}
+
+func (o StatefulStringPairOutput) ToStatefulStringPairOutputWithContext(ctx context.Context) StatefulStringPairOutput {
+	return o
+}
+
+// The fields to pin, by name. At least two are required.
+func (o StatefulStringPairOutput) Fields() PairFieldMapOutput {
+	return o.ApplyT(func(v *StatefulStringPair) PairFieldMapOutput { return v.Fields }).(PairFieldMapOutput)
+}
+
+// Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+func (o StatefulStringPairOutput) Triggers() pulumi.MapOutput {
+	return o.ApplyT(func(v *StatefulStringPair) pulumi.MapOutput { return v.Triggers }).(pulumi.MapOutput)
+}
+
+// The pinned value of every field, by field name.
+func (o StatefulStringPairOutput) Values() pulumi.StringMapOutput {
+	return o.ApplyT(func(v *StatefulStringPair) pulumi.StringMapOutput { return v.Values }).(pulumi.StringMapOutput)
+}
+
+func init() {
+	pulumi.RegisterInputType(reflect.TypeOf((*StatefulStringPairInput)(nil)).Elem(), &StatefulStringPair{})
+	pulumi.RegisterOutputType(StatefulStringPairOutput{})
+}
@@ -0,0 +1,53 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+declare var exports: any;
+const __config = new pulumi.Config("statefulString");
+
+/**
+ * The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+ */
+export declare const archiveDir: string | undefined;
+Object.defineProperty(exports, "archiveDir", {
+    get() {
+        return __config.get("archiveDir");
+    },
+    enumerable: true,
+});
+
+/**
+ * The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+ */
+export declare const deriveSeed: string | undefined;
+Object.defineProperty(exports, "deriveSeed", {
+    get() {
+        return __config.get("deriveSeed");
+    },
+    enumerable: true,
+});
+
+/**
+ * The path of the JSON file rotation groups are read from.
+ */
+export declare const rotationManifest: string | undefined;
+Object.defineProperty(exports, "rotationManifest", {
+    get() {
+        return __config.get("rotationManifest");
+    },
+    enumerable: true,
+});
+
+/**
+ * The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+ */
+export declare const sharedStoreDir: string | undefined;
+Object.defineProperty(exports, "sharedStoreDir", {
+    get() {
+        return __config.get("sharedStoreDir");
+    },
+    enumerable: true,
+});
+
@@ -0,0 +1,43 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Computes the shared trigger value of a rotation group from the provider's rotation manifest, for use as a trigger on resources outside the group.
+ */
+export function getRotationGroupTrigger(args: GetRotationGroupTriggerArgs, opts?: pulumi.InvokeOptions): Promise<GetRotationGroupTriggerResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("statefulString:index:getRotationGroupTrigger", {
+        "group": args.group,
+    }, opts);
+}
+
+export interface GetRotationGroupTriggerArgs {
+    /**
+     * The name of the group in the rotation manifest.
+     */
+    group: string;
+}
+
+export interface GetRotationGroupTriggerResult {
+    /**
+     * The trigger value of the group, which changes whenever its manifest entry does.
+     */
+    readonly trigger: string;
+}
+/**
+ * Computes the shared trigger value of a rotation group from the provider's rotation manifest, for use as a trigger on resources outside the group.
+ */
+export function getRotationGroupTriggerOutput(args: GetRotationGroupTriggerOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetRotationGroupTriggerResult> {
+    return pulumi.output(args).apply((a: any) => getRotationGroupTrigger(a, opts))
+}
+
+export interface GetRotationGroupTriggerOutputArgs {
+    /**
+     * The name of the group in the rotation manifest.
+     */
+    group: pulumi.Input<string>;
+}
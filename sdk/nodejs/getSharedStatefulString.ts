@@ -0,0 +1,51 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * Reads a string published by a SharedStatefulString, possibly from another stack.
+ */
+export function getSharedStatefulString(args: GetSharedStatefulStringArgs, opts?: pulumi.InvokeOptions): Promise<GetSharedStatefulStringResult> {
+
+    opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts || {});
+    return pulumi.runtime.invoke("statefulString:index:getSharedStatefulString", {
+        "key": args.key,
+    }, opts);
+}
+
+export interface GetSharedStatefulStringArgs {
+    /**
+     * The name of the record in the shared store.
+     */
+    key: string;
+}
+
+export interface GetSharedStatefulStringResult {
+    /**
+     * The published string.
+     */
+    readonly string: string;
+    /**
+     * The triggers the string was last pinned with.
+     */
+    readonly triggers?: {[key: string]: any};
+    /**
+     * The RFC 3339 time at which the record was last published.
+     */
+    readonly updatedAt: string;
+}
+/**
+ * Reads a string published by a SharedStatefulString, possibly from another stack.
+ */
+export function getSharedStatefulStringOutput(args: GetSharedStatefulStringOutputArgs, opts?: pulumi.InvokeOptions): pulumi.Output<GetSharedStatefulStringResult> {
+    return pulumi.output(args).apply((a: any) => getSharedStatefulString(a, opts))
+}
+
+export interface GetSharedStatefulStringOutputArgs {
+    /**
+     * The name of the record in the shared store.
+     */
+    key: pulumi.Input<string>;
+}
@@ -0,0 +1,81 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file is written by hand. The Makefile lays it over the generated SDK.
+
+import * as crypto from "crypto";
+import * as fs from "fs";
+import * as path from "path";
+import * as pulumi from "@pulumi/pulumi";
+import { StatefulString } from "./statefulString";
+
+export type Triggers = {[key: string]: pulumi.Input<any>};
+
+/**
+ * Returns triggers that change whenever the content of one of the files changes. Each file maps to
+ * the SHA-256 of its content, so the content itself never reaches the state. The key is the base name
+ * of the file with every character other than a letter, digit, `_` or `-` replaced by `_`, so that it
+ * does not depend on where the program runs; two files with the same key are an error.
+ */
+export function triggersFromFiles(...paths: string[]): Triggers {
+    const triggers: Triggers = {};
+    for (const file of paths) {
+        const key = fileTriggerKey(file);
+        if (key in triggers) {
+            throw new Error(`${file}: another file already uses the trigger key "${key}"`);
+        }
+        triggers[key] = crypto.createHash("sha256").update(fs.readFileSync(file)).digest("hex");
+    }
+    return triggers;
+}
+
+function fileTriggerKey(file: string): string {
+    return path.basename(file).replace(/[^A-Za-z0-9_-]/g, "_");
+}
+
+/**
+ * Returns triggers that change whenever one of the outputs resolves to a new value.
+ */
+export function triggersFromOutputs(outputs: {[key: string]: pulumi.Input<any>}): Triggers {
+    return { ...outputs };
+}
+
+/**
+ * Returns a trigger that changes whenever the image is rebuilt. A reference pinned by digest, such as
+ * `repo:tag@sha256:...`, triggers on its digest alone; any other reference triggers on the whole
+ * reference.
+ */
+export function triggerOnImageDigest(image: pulumi.Input<string>): Triggers {
+    return { imageDigest: pulumi.output(image).apply(imageDigest) };
+}
+
+function imageDigest(image: string): string {
+    const i = image.lastIndexOf("@");
+    return i >= 0 ? image.substring(i + 1) : image;
+}
+
+/**
+ * Combines several sets of triggers into one. Later sets win when a key appears more than once.
+ */
+export function mergeTriggers(...sets: Triggers[]): Triggers {
+    return Object.assign({}, ...sets);
+}
+
+/**
+ * Shorthand for a StatefulString that pins `value` until one of `triggers` changes.
+ */
+export function pinned(name: string, value: pulumi.Input<string>, triggers: pulumi.Input<Triggers>,
+    opts?: pulumi.CustomResourceOptions): StatefulString {
+    return new StatefulString(name, { string: value, triggers }, opts);
+}
@@ -5,23 +5,81 @@ import * as pulumi from "@pulumi/pulumi";
 import * as utilities from "./utilities";
 
 // Export members:
+export { GetRotationGroupTriggerArgs, GetRotationGroupTriggerResult, GetRotationGroupTriggerOutputArgs } from "./getRotationGroupTrigger";
+export const getRotationGroupTrigger: typeof import("./getRotationGroupTrigger").getRotationGroupTrigger = null as any;
+export const getRotationGroupTriggerOutput: typeof import("./getRotationGroupTrigger").getRotationGroupTriggerOutput = null as any;
+utilities.lazyLoad(exports, ["getRotationGroupTrigger","getRotationGroupTriggerOutput"], () => require("./getRotationGroupTrigger"));
+
+export { GetSharedStatefulStringArgs, GetSharedStatefulStringResult, GetSharedStatefulStringOutputArgs } from "./getSharedStatefulString";
+export const getSharedStatefulString: typeof import("./getSharedStatefulString").getSharedStatefulString = null as any;
+export const getSharedStatefulStringOutput: typeof import("./getSharedStatefulString").getSharedStatefulStringOutput = null as any;
+utilities.lazyLoad(exports, ["getSharedStatefulString","getSharedStatefulStringOutput"], () => require("./getSharedStatefulString"));
+
+export * from "./helpers";
 export { ProviderArgs } from "./provider";
 export type Provider = import("./provider").Provider;
 export const Provider: typeof import("./provider").Provider = null as any;
 utilities.lazyLoad(exports, ["Provider"], () => require("./provider"));
 
+export { SecretBundleArgs } from "./secretBundle";
+export type SecretBundle = import("./secretBundle").SecretBundle;
+export const SecretBundle: typeof import("./secretBundle").SecretBundle = null as any;
+utilities.lazyLoad(exports, ["SecretBundle"], () => require("./secretBundle"));
+
+export { SharedStatefulStringArgs } from "./sharedStatefulString";
+export type SharedStatefulString = import("./sharedStatefulString").SharedStatefulString;
+export const SharedStatefulString: typeof import("./sharedStatefulString").SharedStatefulString = null as any;
+utilities.lazyLoad(exports, ["SharedStatefulString"], () => require("./sharedStatefulString"));
+
+export { StatefulCertificateArgs } from "./statefulCertificate";
+export type StatefulCertificate = import("./statefulCertificate").StatefulCertificate;
+export const StatefulCertificate: typeof import("./statefulCertificate").StatefulCertificate = null as any;
+utilities.lazyLoad(exports, ["StatefulCertificate"], () => require("./statefulCertificate"));
+
+export { StatefulKeyPairArgs } from "./statefulKeyPair";
+export type StatefulKeyPair = import("./statefulKeyPair").StatefulKeyPair;
+export const StatefulKeyPair: typeof import("./statefulKeyPair").StatefulKeyPair = null as any;
+utilities.lazyLoad(exports, ["StatefulKeyPair"], () => require("./statefulKeyPair"));
+
 export { StatefulStringArgs } from "./statefulString";
 export type StatefulString = import("./statefulString").StatefulString;
 export const StatefulString: typeof import("./statefulString").StatefulString = null as any;
 utilities.lazyLoad(exports, ["StatefulString"], () => require("./statefulString"));
 
+export { StatefulStringPairArgs } from "./statefulStringPair";
+export type StatefulStringPair = import("./statefulStringPair").StatefulStringPair;
+export const StatefulStringPair: typeof import("./statefulStringPair").StatefulStringPair = null as any;
+utilities.lazyLoad(exports, ["StatefulStringPair"], () => require("./statefulStringPair"));
+
+
+// Export enums:
+export * from "./types/enums";
+
+// Export sub-modules:
+import * as config from "./config";
+import * as types from "./types";
+
+export {
+    config,
+    types,
+};
 
 const _module = {
     version: utilities.getVersion(),
     construct: (name: string, type: string, urn: string): pulumi.Resource => {
         switch (type) {
+            case "statefulString:index:SecretBundle":
+                return new SecretBundle(name, <any>undefined, { urn })
+            case "statefulString:index:SharedStatefulString":
+                return new SharedStatefulString(name, <any>undefined, { urn })
+            case "statefulString:index:StatefulCertificate":
+                return new StatefulCertificate(name, <any>undefined, { urn })
+            case "statefulString:index:StatefulKeyPair":
+                return new StatefulKeyPair(name, <any>undefined, { urn })
             case "statefulString:index:StatefulString":
                 return new StatefulString(name, <any>undefined, { urn })
+            case "statefulString:index:StatefulStringPair":
+                return new StatefulStringPair(name, <any>undefined, { urn })
             default:
                 throw new Error(`unknown resource type ${type}`);
         }
@@ -19,6 +19,22 @@ export class Provider extends pulumi.ProviderResource {
         return obj['__pulumiType'] === "pulumi:providers:" + Provider.__pulumiType;
     }
 
+    /**
+     * The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+     */
+    public readonly archiveDir!: pulumi.Output<string | undefined>;
+    /**
+     * The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+     */
+    public readonly deriveSeed!: pulumi.Output<string | undefined>;
+    /**
+     * The path of the JSON file rotation groups are read from.
+     */
+    public readonly rotationManifest!: pulumi.Output<string | undefined>;
+    /**
+     * The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+     */
+    public readonly sharedStoreDir!: pulumi.Output<string | undefined>;
 
     /**
      * Create a Provider resource with the given unique name, arguments, and options.
@@ -31,8 +47,14 @@ export class Provider extends pulumi.ProviderResource {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         {
+            resourceInputs["archiveDir"] = args ? args.archiveDir : undefined;
+            resourceInputs["deriveSeed"] = args?.deriveSeed ? pulumi.secret(args.deriveSeed) : undefined;
+            resourceInputs["rotationManifest"] = args ? args.rotationManifest : undefined;
+            resourceInputs["sharedStoreDir"] = args ? args.sharedStoreDir : undefined;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["deriveSeed"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(Provider.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -41,4 +63,20 @@ export class Provider extends pulumi.ProviderResource {
  * The set of arguments for constructing a Provider resource.
  */
 export interface ProviderArgs {
+    /**
+     * The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+     */
+    archiveDir?: pulumi.Input<string>;
+    /**
+     * The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+     */
+    deriveSeed?: pulumi.Input<string>;
+    /**
+     * The path of the JSON file rotation groups are read from.
+     */
+    rotationManifest?: pulumi.Input<string>;
+    /**
+     * The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+     */
+    sharedStoreDir?: pulumi.Input<string>;
 }
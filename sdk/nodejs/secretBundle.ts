@@ -0,0 +1,125 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+import * as utilities from "./utilities";
+
+/**
+ * A generated value pinned by a StatefulString, together with the forms programs derive from it. Every output rotates with the pinned value.
+ */
+export class SecretBundle extends pulumi.ComponentResource {
+    /** @internal */
+    public static readonly __pulumiType = 'statefulString:index:SecretBundle';
+
+    /**
+     * Returns true if the given object is an instance of SecretBundle.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SecretBundle {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SecretBundle.__pulumiType;
+    }
+
+    /**
+     * The argon2id hash of the pinned value, when `hashes.argon2` is set.
+     */
+    public /*out*/ readonly argon2Hash!: pulumi.Output<string | undefined>;
+    /**
+     * The bcrypt hash of the pinned value, when `hashes.bcrypt` is set.
+     */
+    public /*out*/ readonly bcryptHash!: pulumi.Output<string | undefined>;
+    /**
+     * The RFC 3339 time at which the pinned value expires.
+     */
+    public /*out*/ readonly expiresAt!: pulumi.Output<string | undefined>;
+    /**
+     * How many values have been pinned, starting from 1.
+     */
+    public /*out*/ readonly revision!: pulumi.Output<number>;
+    /**
+     * The scrypt hash of the pinned value, when `hashes.scrypt` is set.
+     */
+    public /*out*/ readonly scryptHash!: pulumi.Output<string | undefined>;
+    /**
+     * The hex SHA-256 digest of the pinned value.
+     */
+    public /*out*/ readonly sha256!: pulumi.Output<string>;
+    /**
+     * The pinned value.
+     */
+    public /*out*/ readonly value!: pulumi.Output<string>;
+    /**
+     * The variants of the pinned value, by name.
+     */
+    public readonly variants!: pulumi.Output<{[key: string]: string}>;
+
+    /**
+     * Create a SecretBundle resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args?: SecretBundleArgs, opts?: pulumi.ComponentResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            resourceInputs["expiresAfter"] = args ? args.expiresAfter : undefined;
+            resourceInputs["generator"] = args ? args.generator : undefined;
+            resourceInputs["hashes"] = args ? args.hashes : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["variants"] = args ? args.variants : undefined;
+            resourceInputs["argon2Hash"] = undefined /*out*/;
+            resourceInputs["bcryptHash"] = undefined /*out*/;
+            resourceInputs["expiresAt"] = undefined /*out*/;
+            resourceInputs["revision"] = undefined /*out*/;
+            resourceInputs["scryptHash"] = undefined /*out*/;
+            resourceInputs["sha256"] = undefined /*out*/;
+            resourceInputs["value"] = undefined /*out*/;
+        } else {
+            resourceInputs["argon2Hash"] = undefined /*out*/;
+            resourceInputs["bcryptHash"] = undefined /*out*/;
+            resourceInputs["expiresAt"] = undefined /*out*/;
+            resourceInputs["revision"] = undefined /*out*/;
+            resourceInputs["scryptHash"] = undefined /*out*/;
+            resourceInputs["sha256"] = undefined /*out*/;
+            resourceInputs["value"] = undefined /*out*/;
+            resourceInputs["variants"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["argon2Hash", "bcryptHash", "scryptHash", "sha256", "value", "variants"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(SecretBundle.__pulumiType, name, resourceInputs, opts, true /*remote*/);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SecretBundle resource.
+ */
+export interface SecretBundleArgs {
+    /**
+     * How long a pinned value lives, such as `720h`.
+     */
+    expiresAfter?: pulumi.Input<string>;
+    /**
+     * How to generate the pinned value. Defaults to 32 random letters and digits.
+     */
+    generator?: pulumi.Input<inputs.GeneratorArgs>;
+    /**
+     * The salted hashes of the pinned value to compute.
+     */
+    hashes?: pulumi.Input<inputs.HashOptionsArgs>;
+    /**
+     * Arbitrary values that rotate the value, and everything derived from it, whenever any of them changes.
+     */
+    triggers?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * Other forms of the pinned value to derive, by name. A variant only changes when the pinned value does.
+     */
+    variants?: pulumi.Input<{[key: string]: pulumi.Input<inputs.BundleVariantArgs>}>;
+}
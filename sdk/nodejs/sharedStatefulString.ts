@@ -0,0 +1,102 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as utilities from "./utilities";
+
+/**
+ * A string that stays pinned until one of its triggers changes, published with its triggers to the provider's shared store so that other stacks can read it with `getSharedStatefulString`.
+ */
+export class SharedStatefulString extends pulumi.CustomResource {
+    /**
+     * Get an existing SharedStatefulString resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): SharedStatefulString {
+        return new SharedStatefulString(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'statefulString:index:SharedStatefulString';
+
+    /**
+     * Returns true if the given object is an instance of SharedStatefulString.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is SharedStatefulString {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === SharedStatefulString.__pulumiType;
+    }
+
+    /**
+     * The name of the record in the shared store. Changing it replaces the resource.
+     */
+    public readonly key!: pulumi.Output<string>;
+    /**
+     * Identifies this resource as the owner of the record in the shared store.
+     */
+    public /*out*/ readonly ownerId!: pulumi.Output<string>;
+    /**
+     * The string to pin. Changes are only picked up when a trigger changes.
+     */
+    public readonly string!: pulumi.Output<string>;
+    /**
+     * Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+     */
+    public readonly triggers!: pulumi.Output<{[key: string]: any} | undefined>;
+
+    /**
+     * Create a SharedStatefulString resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: SharedStatefulStringArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.key === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'key'");
+            }
+            if ((!args || args.string === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'string'");
+            }
+            resourceInputs["key"] = args ? args.key : undefined;
+            resourceInputs["string"] = args ? args.string : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["ownerId"] = undefined /*out*/;
+        } else {
+            resourceInputs["key"] = undefined /*out*/;
+            resourceInputs["ownerId"] = undefined /*out*/;
+            resourceInputs["string"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        super(SharedStatefulString.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a SharedStatefulString resource.
+ */
+export interface SharedStatefulStringArgs {
+    /**
+     * The name of the record in the shared store. Changing it replaces the resource.
+     */
+    key: pulumi.Input<string>;
+    /**
+     * The string to pin. Changes are only picked up when a trigger changes.
+     */
+    string: pulumi.Input<string>;
+    /**
+     * Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+     */
+    triggers?: pulumi.Input<{[key: string]: any}>;
+}
@@ -0,0 +1,218 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+import * as utilities from "./utilities";
+
+/**
+ * A self-signed or locally-CA-signed certificate that stays pinned until its contents or triggers change, or until it is within `renewBefore` of expiring.
+ */
+export class StatefulCertificate extends pulumi.CustomResource {
+    /**
+     * Get an existing StatefulCertificate resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): StatefulCertificate {
+        return new StatefulCertificate(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'statefulString:index:StatefulCertificate';
+
+    /**
+     * Returns true if the given object is an instance of StatefulCertificate.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is StatefulCertificate {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === StatefulCertificate.__pulumiType;
+    }
+
+    /**
+     * The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+     */
+    public readonly caCertPem!: pulumi.Output<string | undefined>;
+    /**
+     * The PEM private key of the CA that signs the certificate.
+     */
+    public readonly caPrivateKeyPem!: pulumi.Output<string | undefined>;
+    /**
+     * The certificate in PEM format.
+     */
+    public /*out*/ readonly certPem!: pulumi.Output<string>;
+    /**
+     * The DNS names the certificate is valid for.
+     */
+    public readonly dnsNames!: pulumi.Output<string[] | undefined>;
+    /**
+     * The curve of an ECDSA key. Defaults to `P256`.
+     */
+    public readonly ecdsaCurve!: pulumi.Output<enums.ECDSACurve | undefined>;
+    /**
+     * The hex SHA-256 digest of the DER certificate.
+     */
+    public /*out*/ readonly fingerprintSha256!: pulumi.Output<string>;
+    /**
+     * The IP addresses the certificate is valid for.
+     */
+    public readonly ipAddresses!: pulumi.Output<string[] | undefined>;
+    /**
+     * Whether the certificate can sign other certificates, for use as a local CA.
+     */
+    public readonly isCa!: pulumi.Output<boolean | undefined>;
+    /**
+     * The kind of key to generate. Defaults to `ecdsa`.
+     */
+    public readonly keyAlgorithm!: pulumi.Output<enums.KeyAlgorithm | undefined>;
+    /**
+     * The RFC 3339 time at which the certificate expires.
+     */
+    public /*out*/ readonly notAfter!: pulumi.Output<string>;
+    /**
+     * The RFC 3339 time from which the certificate is valid.
+     */
+    public /*out*/ readonly notBefore!: pulumi.Output<string>;
+    /**
+     * The private key of the certificate in PKCS #8 PEM format.
+     */
+    public /*out*/ readonly privateKeyPem!: pulumi.Output<string>;
+    /**
+     * How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+     */
+    public readonly renewBefore!: pulumi.Output<string | undefined>;
+    /**
+     * The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+     */
+    public readonly rsaBits!: pulumi.Output<number | undefined>;
+    /**
+     * The subject of the certificate.
+     */
+    public readonly subject!: pulumi.Output<outputs.CertificateSubject>;
+    /**
+     * Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+     */
+    public readonly triggers!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+     */
+    public readonly validFor!: pulumi.Output<string | undefined>;
+
+    /**
+     * Create a StatefulCertificate resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: StatefulCertificateArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.subject === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'subject'");
+            }
+            resourceInputs["caCertPem"] = args ? args.caCertPem : undefined;
+            resourceInputs["caPrivateKeyPem"] = args?.caPrivateKeyPem ? pulumi.secret(args.caPrivateKeyPem) : undefined;
+            resourceInputs["dnsNames"] = args ? args.dnsNames : undefined;
+            resourceInputs["ecdsaCurve"] = args ? args.ecdsaCurve : undefined;
+            resourceInputs["ipAddresses"] = args ? args.ipAddresses : undefined;
+            resourceInputs["isCa"] = args ? args.isCa : undefined;
+            resourceInputs["keyAlgorithm"] = args ? args.keyAlgorithm : undefined;
+            resourceInputs["renewBefore"] = args ? args.renewBefore : undefined;
+            resourceInputs["rsaBits"] = args ? args.rsaBits : undefined;
+            resourceInputs["subject"] = args ? args.subject : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["validFor"] = args ? args.validFor : undefined;
+            resourceInputs["certPem"] = undefined /*out*/;
+            resourceInputs["fingerprintSha256"] = undefined /*out*/;
+            resourceInputs["notAfter"] = undefined /*out*/;
+            resourceInputs["notBefore"] = undefined /*out*/;
+            resourceInputs["privateKeyPem"] = undefined /*out*/;
+        } else {
+            resourceInputs["caCertPem"] = undefined /*out*/;
+            resourceInputs["caPrivateKeyPem"] = undefined /*out*/;
+            resourceInputs["certPem"] = undefined /*out*/;
+            resourceInputs["dnsNames"] = undefined /*out*/;
+            resourceInputs["ecdsaCurve"] = undefined /*out*/;
+            resourceInputs["fingerprintSha256"] = undefined /*out*/;
+            resourceInputs["ipAddresses"] = undefined /*out*/;
+            resourceInputs["isCa"] = undefined /*out*/;
+            resourceInputs["keyAlgorithm"] = undefined /*out*/;
+            resourceInputs["notAfter"] = undefined /*out*/;
+            resourceInputs["notBefore"] = undefined /*out*/;
+            resourceInputs["privateKeyPem"] = undefined /*out*/;
+            resourceInputs["renewBefore"] = undefined /*out*/;
+            resourceInputs["rsaBits"] = undefined /*out*/;
+            resourceInputs["subject"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+            resourceInputs["validFor"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["caPrivateKeyPem", "privateKeyPem"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(StatefulCertificate.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a StatefulCertificate resource.
+ */
+export interface StatefulCertificateArgs {
+    /**
+     * The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+     */
+    caCertPem?: pulumi.Input<string>;
+    /**
+     * The PEM private key of the CA that signs the certificate.
+     */
+    caPrivateKeyPem?: pulumi.Input<string>;
+    /**
+     * The DNS names the certificate is valid for.
+     */
+    dnsNames?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The curve of an ECDSA key. Defaults to `P256`.
+     */
+    ecdsaCurve?: pulumi.Input<enums.ECDSACurve>;
+    /**
+     * The IP addresses the certificate is valid for.
+     */
+    ipAddresses?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Whether the certificate can sign other certificates, for use as a local CA.
+     */
+    isCa?: pulumi.Input<boolean>;
+    /**
+     * The kind of key to generate. Defaults to `ecdsa`.
+     */
+    keyAlgorithm?: pulumi.Input<enums.KeyAlgorithm>;
+    /**
+     * How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+     */
+    renewBefore?: pulumi.Input<string>;
+    /**
+     * The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+     */
+    rsaBits?: pulumi.Input<number>;
+    /**
+     * The subject of the certificate.
+     */
+    subject: pulumi.Input<inputs.CertificateSubjectArgs>;
+    /**
+     * Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+     */
+    triggers?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+     */
+    validFor?: pulumi.Input<string>;
+}
@@ -0,0 +1,138 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+import * as utilities from "./utilities";
+
+/**
+ * A generated key pair that stays pinned until one of its triggers changes. Changes to the key settings are only applied by the next rotation.
+ */
+export class StatefulKeyPair extends pulumi.CustomResource {
+    /**
+     * Get an existing StatefulKeyPair resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): StatefulKeyPair {
+        return new StatefulKeyPair(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'statefulString:index:StatefulKeyPair';
+
+    /**
+     * Returns true if the given object is an instance of StatefulKeyPair.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is StatefulKeyPair {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === StatefulKeyPair.__pulumiType;
+    }
+
+    /**
+     * The kind of key to generate.
+     */
+    public readonly algorithm!: pulumi.Output<enums.KeyAlgorithm>;
+    /**
+     * The curve of an ECDSA key. Defaults to `P256`.
+     */
+    public readonly ecdsaCurve!: pulumi.Output<enums.ECDSACurve | undefined>;
+    /**
+     * The OpenSSH SHA256 fingerprint of the public key.
+     */
+    public /*out*/ readonly fingerprintSha256!: pulumi.Output<string>;
+    /**
+     * The private key in OpenSSH format.
+     */
+    public /*out*/ readonly privateKeyOpenssh!: pulumi.Output<string>;
+    /**
+     * The private key in PKCS #8 PEM format.
+     */
+    public /*out*/ readonly privateKeyPem!: pulumi.Output<string>;
+    /**
+     * The public key in OpenSSH authorized_keys format.
+     */
+    public /*out*/ readonly publicKeyOpenssh!: pulumi.Output<string>;
+    /**
+     * The public key in PKIX PEM format.
+     */
+    public /*out*/ readonly publicKeyPem!: pulumi.Output<string>;
+    /**
+     * The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+     */
+    public readonly rsaBits!: pulumi.Output<number | undefined>;
+    /**
+     * Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+     */
+    public readonly triggers!: pulumi.Output<{[key: string]: any} | undefined>;
+
+    /**
+     * Create a StatefulKeyPair resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: StatefulKeyPairArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.algorithm === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'algorithm'");
+            }
+            resourceInputs["algorithm"] = args ? args.algorithm : undefined;
+            resourceInputs["ecdsaCurve"] = args ? args.ecdsaCurve : undefined;
+            resourceInputs["rsaBits"] = args ? args.rsaBits : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["fingerprintSha256"] = undefined /*out*/;
+            resourceInputs["privateKeyOpenssh"] = undefined /*out*/;
+            resourceInputs["privateKeyPem"] = undefined /*out*/;
+            resourceInputs["publicKeyOpenssh"] = undefined /*out*/;
+            resourceInputs["publicKeyPem"] = undefined /*out*/;
+        } else {
+            resourceInputs["algorithm"] = undefined /*out*/;
+            resourceInputs["ecdsaCurve"] = undefined /*out*/;
+            resourceInputs["fingerprintSha256"] = undefined /*out*/;
+            resourceInputs["privateKeyOpenssh"] = undefined /*out*/;
+            resourceInputs["privateKeyPem"] = undefined /*out*/;
+            resourceInputs["publicKeyOpenssh"] = undefined /*out*/;
+            resourceInputs["publicKeyPem"] = undefined /*out*/;
+            resourceInputs["rsaBits"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["privateKeyOpenssh", "privateKeyPem"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(StatefulKeyPair.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a StatefulKeyPair resource.
+ */
+export interface StatefulKeyPairArgs {
+    /**
+     * The kind of key to generate.
+     */
+    algorithm: pulumi.Input<enums.KeyAlgorithm>;
+    /**
+     * The curve of an ECDSA key. Defaults to `P256`.
+     */
+    ecdsaCurve?: pulumi.Input<enums.ECDSACurve>;
+    /**
+     * The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+     */
+    rsaBits?: pulumi.Input<number>;
+    /**
+     * Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+     */
+    triggers?: pulumi.Input<{[key: string]: any}>;
+}
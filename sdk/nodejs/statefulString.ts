@@ -2,8 +2,14 @@
 // *** Do not edit by hand unless you're certain you know what you are doing! ***
 
 import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
 import * as utilities from "./utilities";
 
+/**
+ * A string that stays pinned to its first value until one of its triggers changes, at which point the latest `string` input is pinned instead.
+ */
 export class StatefulString extends pulumi.CustomResource {
     /**
      * Get an existing StatefulString resource's state with the given name, ID, and optional extra
@@ -31,8 +37,178 @@ export class StatefulString extends pulumi.CustomResource {
         return obj['__pulumiType'] === StatefulString.__pulumiType;
     }
 
-    public readonly string!: pulumi.Output<string>;
-    public readonly triggers!: pulumi.Output<{[key: string]: string}>;
+    /**
+     * The only values the string may take before it is pinned.
+     */
+    public readonly allowedValues!: pulumi.Output<string[] | undefined>;
+    /**
+     * The argon2id hash of the pinned string in PHC format, when `hashes.argon2` is set.
+     */
+    public /*out*/ readonly argon2Hash!: pulumi.Output<string | undefined>;
+    /**
+     * The bcrypt hash of the pinned string, when `hashes.bcrypt` is set.
+     */
+    public /*out*/ readonly bcryptHash!: pulumi.Output<string | undefined>;
+    /**
+     * The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+     */
+    public readonly charset!: pulumi.Output<string | undefined>;
+    /**
+     * The pinned string.
+     */
+    public /*out*/ readonly current!: pulumi.Output<string | undefined>;
+    /**
+     * The value of the source as of the last refresh or rotation.
+     */
+    public /*out*/ readonly currentSourceValue!: pulumi.Output<string | undefined>;
+    /**
+     * The latest `string` input, even when it has not been pinned.
+     */
+    public /*out*/ readonly desiredString!: pulumi.Output<string | undefined>;
+    /**
+     * How long a pinned string lives, such as `720h`.
+     */
+    public readonly expiresAfter!: pulumi.Output<string | undefined>;
+    /**
+     * The RFC 3339 time at which the pinned string expires.
+     */
+    public /*out*/ readonly expiresAt!: pulumi.Output<string | undefined>;
+    /**
+     * A nonce: any change to it pins the string regardless of triggers.
+     */
+    public readonly forceRotate!: pulumi.Output<string | undefined>;
+    /**
+     * How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+     */
+    public readonly generator!: pulumi.Output<outputs.Generator | undefined>;
+    /**
+     * The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+     */
+    public readonly hashes!: pulumi.Output<outputs.HashOptions | undefined>;
+    /**
+     * Whether the latest `string` input differs from the pinned string.
+     */
+    public /*out*/ readonly isStale!: pulumi.Output<boolean | undefined>;
+    /**
+     * A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+     */
+    public readonly jsonSchema!: pulumi.Output<string | undefined>;
+    /**
+     * The most characters the string may have before it is pinned.
+     */
+    public readonly maxLength!: pulumi.Output<number | undefined>;
+    /**
+     * The fewest characters the string may have before it is pinned.
+     */
+    public readonly minLength!: pulumi.Output<number | undefined>;
+    /**
+     * What happens once the pinned string has expired. Defaults to `warn`.
+     */
+    public readonly onExpiry!: pulumi.Output<enums.ExpiryPolicy | undefined>;
+    /**
+     * The pinned string decoded as JSON. Only set when `jsonSchema` is given.
+     */
+    public /*out*/ readonly parsed!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * A regular expression the string must match before it is pinned.
+     */
+    public readonly pattern!: pulumi.Output<string | undefined>;
+    /**
+     * The string staged by a rotation and waiting to be promoted.
+     */
+    public /*out*/ readonly pending!: pulumi.Output<string | undefined>;
+    /**
+     * How many updates have happened since `pending` was staged.
+     */
+    public /*out*/ readonly pendingUpdates!: pulumi.Output<number | undefined>;
+    /**
+     * The string replaced by the last rotation, until `previousRetainedUntil`.
+     */
+    public /*out*/ readonly previous!: pulumi.Output<string | undefined>;
+    /**
+     * The RFC 3339 time until which `previous` is kept.
+     */
+    public /*out*/ readonly previousRetainedUntil!: pulumi.Output<string | undefined>;
+    /**
+     * How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+     */
+    public readonly previousRetention!: pulumi.Output<string | undefined>;
+    /**
+     * A nonce: any change to it pins the pending string.
+     */
+    public readonly promote!: pulumi.Output<string | undefined>;
+    /**
+     * Pin the pending string automatically on this many updates after it was staged.
+     */
+    public readonly promoteAfterUpdates!: pulumi.Output<number | undefined>;
+    /**
+     * The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+     */
+    public readonly restoreFrom!: pulumi.Output<string | undefined>;
+    /**
+     * The pinned string after `transforms` have been applied.
+     */
+    public /*out*/ readonly result!: pulumi.Output<string | undefined>;
+    /**
+     * Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+     */
+    public readonly retainOnDelete!: pulumi.Output<boolean | undefined>;
+    /**
+     * How many strings have been pinned, starting from 1.
+     */
+    public /*out*/ readonly revision!: pulumi.Output<number | undefined>;
+    /**
+     * Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+     */
+    public readonly rotateOnDrift!: pulumi.Output<boolean | undefined>;
+    /**
+     * A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+     */
+    public readonly rotationGroup!: pulumi.Output<string | undefined>;
+    /**
+     * The trigger value of the rotation group as of the last update.
+     */
+    public /*out*/ readonly rotationGroupTrigger!: pulumi.Output<string | undefined>;
+    /**
+     * Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+     */
+    public readonly rotationMode!: pulumi.Output<enums.RotationMode | undefined>;
+    /**
+     * The scrypt hash of the pinned string in PHC format, when `hashes.scrypt` is set.
+     */
+    public /*out*/ readonly scryptHash!: pulumi.Output<string | undefined>;
+    /**
+     * Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+     */
+    public readonly sensitiveTriggers!: pulumi.Output<string[] | undefined>;
+    /**
+     * Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+     */
+    public readonly source!: pulumi.Output<outputs.Source | undefined>;
+    /**
+     * Whether the source differed from the pinned string at the last refresh.
+     */
+    public /*out*/ readonly sourceDrift!: pulumi.Output<boolean | undefined>;
+    /**
+     * Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+     */
+    public readonly staged!: pulumi.Output<boolean | undefined>;
+    /**
+     * The string to pin. Changes are only picked up when the string rotates.
+     */
+    public readonly string!: pulumi.Output<string | undefined>;
+    /**
+     * Transforms applied in order to the pinned string to compute `result`.
+     */
+    public readonly transforms!: pulumi.Output<outputs.Transform[] | undefined>;
+    /**
+     * Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+     */
+    public readonly triggers!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * How long before expiry every diff starts to warn, such as `72h`.
+     */
+    public readonly warnBefore!: pulumi.Output<string | undefined>;
 
     /**
      * Create a StatefulString resource with the given unique name, arguments, and options.
@@ -41,23 +217,101 @@ export class StatefulString extends pulumi.CustomResource {
      * @param args The arguments to use to populate this resource's properties.
      * @param opts A bag of options that control this resource's behavior.
      */
-    constructor(name: string, args: StatefulStringArgs, opts?: pulumi.CustomResourceOptions) {
+    constructor(name: string, args?: StatefulStringArgs, opts?: pulumi.CustomResourceOptions) {
         let resourceInputs: pulumi.Inputs = {};
         opts = opts || {};
         if (!opts.id) {
-            if ((!args || args.string === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'string'");
-            }
-            if ((!args || args.triggers === undefined) && !opts.urn) {
-                throw new Error("Missing required property 'triggers'");
-            }
+            resourceInputs["allowedValues"] = args ? args.allowedValues : undefined;
+            resourceInputs["charset"] = args ? args.charset : undefined;
+            resourceInputs["expiresAfter"] = args ? args.expiresAfter : undefined;
+            resourceInputs["forceRotate"] = args ? args.forceRotate : undefined;
+            resourceInputs["generator"] = args ? args.generator : undefined;
+            resourceInputs["hashes"] = args ? args.hashes : undefined;
+            resourceInputs["jsonSchema"] = args ? args.jsonSchema : undefined;
+            resourceInputs["maxLength"] = args ? args.maxLength : undefined;
+            resourceInputs["minLength"] = args ? args.minLength : undefined;
+            resourceInputs["onExpiry"] = args ? args.onExpiry : undefined;
+            resourceInputs["pattern"] = args ? args.pattern : undefined;
+            resourceInputs["previousRetention"] = args ? args.previousRetention : undefined;
+            resourceInputs["promote"] = args ? args.promote : undefined;
+            resourceInputs["promoteAfterUpdates"] = args ? args.promoteAfterUpdates : undefined;
+            resourceInputs["restoreFrom"] = args ? args.restoreFrom : undefined;
+            resourceInputs["retainOnDelete"] = args ? args.retainOnDelete : undefined;
+            resourceInputs["rotateOnDrift"] = args ? args.rotateOnDrift : undefined;
+            resourceInputs["rotationGroup"] = args ? args.rotationGroup : undefined;
+            resourceInputs["rotationMode"] = args ? args.rotationMode : undefined;
+            resourceInputs["sensitiveTriggers"] = args ? args.sensitiveTriggers : undefined;
+            resourceInputs["source"] = args ? args.source : undefined;
+            resourceInputs["staged"] = args ? args.staged : undefined;
             resourceInputs["string"] = args ? args.string : undefined;
+            resourceInputs["transforms"] = args ? args.transforms : undefined;
             resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["warnBefore"] = args ? args.warnBefore : undefined;
+            resourceInputs["argon2Hash"] = undefined /*out*/;
+            resourceInputs["bcryptHash"] = undefined /*out*/;
+            resourceInputs["current"] = undefined /*out*/;
+            resourceInputs["currentSourceValue"] = undefined /*out*/;
+            resourceInputs["desiredString"] = undefined /*out*/;
+            resourceInputs["expiresAt"] = undefined /*out*/;
+            resourceInputs["isStale"] = undefined /*out*/;
+            resourceInputs["parsed"] = undefined /*out*/;
+            resourceInputs["pending"] = undefined /*out*/;
+            resourceInputs["pendingUpdates"] = undefined /*out*/;
+            resourceInputs["previous"] = undefined /*out*/;
+            resourceInputs["previousRetainedUntil"] = undefined /*out*/;
+            resourceInputs["result"] = undefined /*out*/;
+            resourceInputs["revision"] = undefined /*out*/;
+            resourceInputs["rotationGroupTrigger"] = undefined /*out*/;
+            resourceInputs["scryptHash"] = undefined /*out*/;
+            resourceInputs["sourceDrift"] = undefined /*out*/;
         } else {
+            resourceInputs["allowedValues"] = undefined /*out*/;
+            resourceInputs["argon2Hash"] = undefined /*out*/;
+            resourceInputs["bcryptHash"] = undefined /*out*/;
+            resourceInputs["charset"] = undefined /*out*/;
+            resourceInputs["current"] = undefined /*out*/;
+            resourceInputs["currentSourceValue"] = undefined /*out*/;
+            resourceInputs["desiredString"] = undefined /*out*/;
+            resourceInputs["expiresAfter"] = undefined /*out*/;
+            resourceInputs["expiresAt"] = undefined /*out*/;
+            resourceInputs["forceRotate"] = undefined /*out*/;
+            resourceInputs["generator"] = undefined /*out*/;
+            resourceInputs["hashes"] = undefined /*out*/;
+            resourceInputs["isStale"] = undefined /*out*/;
+            resourceInputs["jsonSchema"] = undefined /*out*/;
+            resourceInputs["maxLength"] = undefined /*out*/;
+            resourceInputs["minLength"] = undefined /*out*/;
+            resourceInputs["onExpiry"] = undefined /*out*/;
+            resourceInputs["parsed"] = undefined /*out*/;
+            resourceInputs["pattern"] = undefined /*out*/;
+            resourceInputs["pending"] = undefined /*out*/;
+            resourceInputs["pendingUpdates"] = undefined /*out*/;
+            resourceInputs["previous"] = undefined /*out*/;
+            resourceInputs["previousRetainedUntil"] = undefined /*out*/;
+            resourceInputs["previousRetention"] = undefined /*out*/;
+            resourceInputs["promote"] = undefined /*out*/;
+            resourceInputs["promoteAfterUpdates"] = undefined /*out*/;
+            resourceInputs["restoreFrom"] = undefined /*out*/;
+            resourceInputs["result"] = undefined /*out*/;
+            resourceInputs["retainOnDelete"] = undefined /*out*/;
+            resourceInputs["revision"] = undefined /*out*/;
+            resourceInputs["rotateOnDrift"] = undefined /*out*/;
+            resourceInputs["rotationGroup"] = undefined /*out*/;
+            resourceInputs["rotationGroupTrigger"] = undefined /*out*/;
+            resourceInputs["rotationMode"] = undefined /*out*/;
+            resourceInputs["scryptHash"] = undefined /*out*/;
+            resourceInputs["sensitiveTriggers"] = undefined /*out*/;
+            resourceInputs["source"] = undefined /*out*/;
+            resourceInputs["sourceDrift"] = undefined /*out*/;
+            resourceInputs["staged"] = undefined /*out*/;
             resourceInputs["string"] = undefined /*out*/;
+            resourceInputs["transforms"] = undefined /*out*/;
             resourceInputs["triggers"] = undefined /*out*/;
+            resourceInputs["warnBefore"] = undefined /*out*/;
         }
         opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["argon2Hash", "bcryptHash", "scryptHash"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
         super(StatefulString.__pulumiType, name, resourceInputs, opts);
     }
 }
@@ -66,6 +320,108 @@ export class StatefulString extends pulumi.CustomResource {
  * The set of arguments for constructing a StatefulString resource.
  */
 export interface StatefulStringArgs {
-    string: pulumi.Input<string>;
-    triggers: pulumi.Input<{[key: string]: pulumi.Input<string>}>;
+    /**
+     * The only values the string may take before it is pinned.
+     */
+    allowedValues?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+     */
+    charset?: pulumi.Input<string>;
+    /**
+     * How long a pinned string lives, such as `720h`.
+     */
+    expiresAfter?: pulumi.Input<string>;
+    /**
+     * A nonce: any change to it pins the string regardless of triggers.
+     */
+    forceRotate?: pulumi.Input<string>;
+    /**
+     * How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+     */
+    generator?: pulumi.Input<inputs.GeneratorArgs>;
+    /**
+     * The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+     */
+    hashes?: pulumi.Input<inputs.HashOptionsArgs>;
+    /**
+     * A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+     */
+    jsonSchema?: pulumi.Input<string>;
+    /**
+     * The most characters the string may have before it is pinned.
+     */
+    maxLength?: pulumi.Input<number>;
+    /**
+     * The fewest characters the string may have before it is pinned.
+     */
+    minLength?: pulumi.Input<number>;
+    /**
+     * What happens once the pinned string has expired. Defaults to `warn`.
+     */
+    onExpiry?: pulumi.Input<enums.ExpiryPolicy>;
+    /**
+     * A regular expression the string must match before it is pinned.
+     */
+    pattern?: pulumi.Input<string>;
+    /**
+     * How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+     */
+    previousRetention?: pulumi.Input<string>;
+    /**
+     * A nonce: any change to it pins the pending string.
+     */
+    promote?: pulumi.Input<string>;
+    /**
+     * Pin the pending string automatically on this many updates after it was staged.
+     */
+    promoteAfterUpdates?: pulumi.Input<number>;
+    /**
+     * The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+     */
+    restoreFrom?: pulumi.Input<string>;
+    /**
+     * Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+     */
+    retainOnDelete?: pulumi.Input<boolean>;
+    /**
+     * Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+     */
+    rotateOnDrift?: pulumi.Input<boolean>;
+    /**
+     * A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+     */
+    rotationGroup?: pulumi.Input<string>;
+    /**
+     * Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+     */
+    rotationMode?: pulumi.Input<enums.RotationMode>;
+    /**
+     * Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+     */
+    sensitiveTriggers?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+     */
+    source?: pulumi.Input<inputs.SourceArgs>;
+    /**
+     * Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+     */
+    staged?: pulumi.Input<boolean>;
+    /**
+     * The string to pin. Changes are only picked up when the string rotates.
+     */
+    string?: pulumi.Input<string>;
+    /**
+     * Transforms applied in order to the pinned string to compute `result`.
+     */
+    transforms?: pulumi.Input<pulumi.Input<inputs.TransformArgs>[]>;
+    /**
+     * Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+     */
+    triggers?: pulumi.Input<{[key: string]: any}>;
+    /**
+     * How long before expiry every diff starts to warn, such as `72h`.
+     */
+    warnBefore?: pulumi.Input<string>;
 }
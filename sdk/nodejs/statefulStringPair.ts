@@ -0,0 +1,94 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+import * as utilities from "./utilities";
+
+/**
+ * Two or more named strings, such as a username and a password, that share one set of triggers and always rotate together.
+ */
+export class StatefulStringPair extends pulumi.CustomResource {
+    /**
+     * Get an existing StatefulStringPair resource's state with the given name, ID, and optional extra
+     * properties used to qualify the lookup.
+     *
+     * @param name The _unique_ name of the resulting resource.
+     * @param id The _unique_ provider ID of the resource to lookup.
+     * @param opts Optional settings to control the behavior of the CustomResource.
+     */
+    public static get(name: string, id: pulumi.Input<pulumi.ID>, opts?: pulumi.CustomResourceOptions): StatefulStringPair {
+        return new StatefulStringPair(name, undefined as any, { ...opts, id: id });
+    }
+
+    /** @internal */
+    public static readonly __pulumiType = 'statefulString:index:StatefulStringPair';
+
+    /**
+     * Returns true if the given object is an instance of StatefulStringPair.  This is designed to work even
+     * when multiple copies of the Pulumi SDK have been loaded into the same process.
+     */
+    public static isInstance(obj: any): obj is StatefulStringPair {
+        if (obj === undefined || obj === null) {
+            return false;
+        }
+        return obj['__pulumiType'] === StatefulStringPair.__pulumiType;
+    }
+
+    /**
+     * The fields to pin, by name. At least two are required.
+     */
+    public readonly fields!: pulumi.Output<{[key: string]: outputs.PairField}>;
+    /**
+     * Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+     */
+    public readonly triggers!: pulumi.Output<{[key: string]: any} | undefined>;
+    /**
+     * The pinned value of every field, by field name.
+     */
+    public /*out*/ readonly values!: pulumi.Output<{[key: string]: string}>;
+
+    /**
+     * Create a StatefulStringPair resource with the given unique name, arguments, and options.
+     *
+     * @param name The _unique_ name of the resource.
+     * @param args The arguments to use to populate this resource's properties.
+     * @param opts A bag of options that control this resource's behavior.
+     */
+    constructor(name: string, args: StatefulStringPairArgs, opts?: pulumi.CustomResourceOptions) {
+        let resourceInputs: pulumi.Inputs = {};
+        opts = opts || {};
+        if (!opts.id) {
+            if ((!args || args.fields === undefined) && !opts.urn) {
+                throw new Error("Missing required property 'fields'");
+            }
+            resourceInputs["fields"] = args ? args.fields : undefined;
+            resourceInputs["triggers"] = args ? args.triggers : undefined;
+            resourceInputs["values"] = undefined /*out*/;
+        } else {
+            resourceInputs["fields"] = undefined /*out*/;
+            resourceInputs["triggers"] = undefined /*out*/;
+            resourceInputs["values"] = undefined /*out*/;
+        }
+        opts = pulumi.mergeOptions(utilities.resourceOptsDefaults(), opts);
+        const secretOpts = { additionalSecretOutputs: ["values"] };
+        opts = pulumi.mergeOptions(opts, secretOpts);
+        super(StatefulStringPair.__pulumiType, name, resourceInputs, opts);
+    }
+}
+
+/**
+ * The set of arguments for constructing a StatefulStringPair resource.
+ */
+export interface StatefulStringPairArgs {
+    /**
+     * The fields to pin, by name. At least two are required.
+     */
+    fields: pulumi.Input<{[key: string]: pulumi.Input<inputs.PairFieldArgs>}>;
+    /**
+     * Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+     */
+    triggers?: pulumi.Input<{[key: string]: any}>;
+}
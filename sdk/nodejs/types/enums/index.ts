@@ -0,0 +1,138 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+
+export const ECDSACurve = {
+    /**
+     * NIST P-256.
+     */
+    P256: "P256",
+    /**
+     * NIST P-384.
+     */
+    P384: "P384",
+    /**
+     * NIST P-521.
+     */
+    P521: "P521",
+} as const;
+
+export type ECDSACurve = (typeof ECDSACurve)[keyof typeof ECDSACurve];
+
+export const ExpiryPolicy = {
+    /**
+     * Emit a warning on every diff. This is the default.
+     */
+    Warn: "warn",
+    /**
+     * Fail the update until the string is rotated.
+     */
+    Fail: "fail",
+    /**
+     * Pin the current string input as if a trigger changed.
+     */
+    Rotate: "rotate",
+} as const;
+
+export type ExpiryPolicy = (typeof ExpiryPolicy)[keyof typeof ExpiryPolicy];
+
+export const GeneratorKind = {
+    /**
+     * Draw `length` characters uniformly at random from `charset`.
+     */
+    Random: "random",
+    /**
+     * Compute `length` characters from `charset` with an HMAC-SHA256 of the triggers, keyed by the provider's `deriveSeed`. The same seed and triggers always derive the same value.
+     */
+    Derive: "derive",
+    /**
+     * Join `words` words from an embedded wordlist with `separator`: adjectives followed by a noun, such as `brave-otter`.
+     */
+    Petname: "petname",
+} as const;
+
+export type GeneratorKind = (typeof GeneratorKind)[keyof typeof GeneratorKind];
+
+export const KeyAlgorithm = {
+    /**
+     * An Ed25519 key.
+     */
+    Ed25519: "ed25519",
+    /**
+     * An RSA key of `rsaBits` bits.
+     */
+    Rsa: "rsa",
+    /**
+     * An ECDSA key on `ecdsaCurve`.
+     */
+    Ecdsa: "ecdsa",
+} as const;
+
+export type KeyAlgorithm = (typeof KeyAlgorithm)[keyof typeof KeyAlgorithm];
+
+export const RotationMode = {
+    /**
+     * Pin the string whenever a trigger is added, removed or changed. This is the default.
+     */
+    RotateOnTriggerChange: "rotateOnTriggerChange",
+    /**
+     * Pin the string whenever it or a trigger changes.
+     */
+    RotateOnStringChange: "rotateOnStringChange",
+    /**
+     * Pin the string only when the forceRotate nonce changes.
+     */
+    Explicit: "explicit",
+} as const;
+
+export type RotationMode = (typeof RotationMode)[keyof typeof RotationMode];
+
+export const SourceKind = {
+    /**
+     * Read the environment variable `name` of the provider process.
+     */
+    Env: "env",
+    /**
+     * Read the local file at `path`.
+     */
+    File: "file",
+    /**
+     * Run `command` and read its standard output.
+     */
+    Command: "command",
+} as const;
+
+export type SourceKind = (typeof SourceKind)[keyof typeof SourceKind];
+
+export const TransformKind = {
+    /**
+     * Lowercase the value.
+     */
+    Lower: "lower",
+    /**
+     * Uppercase the value.
+     */
+    Upper: "upper",
+    /**
+     * Keep at most `length` characters (63 by default).
+     */
+    Truncate: "truncate",
+    /**
+     * Reduce the value to lowercase letters, digits and single dashes.
+     */
+    Slugify: "slugify",
+    /**
+     * Prepend `value`.
+     */
+    Prefix: "prefix",
+    /**
+     * Append `value`.
+     */
+    Suffix: "suffix",
+    /**
+     * Standard base64 encode the value.
+     */
+    Base64: "base64",
+} as const;
+
+export type TransformKind = (typeof TransformKind)[keyof typeof TransformKind];
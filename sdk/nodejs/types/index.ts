@@ -0,0 +1,15 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as utilities from "./utilities";
+
+// Export sub-modules:
+import * as enums from "./enums";
+import * as input from "./input";
+import * as output from "./output";
+
+export {
+    enums,
+    input,
+    output,
+};
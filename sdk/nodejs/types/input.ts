@@ -0,0 +1,168 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+
+import * as utilities from "./utilities";
+
+export interface Argon2OptionsArgs {
+    /**
+     * The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+     */
+    memory?: pulumi.Input<number>;
+    /**
+     * The degree of parallelism, from 1 to 255. Defaults to 4.
+     */
+    threads?: pulumi.Input<number>;
+    /**
+     * The number of passes over the memory, from 1 to 10. Defaults to 1.
+     */
+    time?: pulumi.Input<number>;
+}
+
+export interface BcryptOptionsArgs {
+    /**
+     * The bcrypt cost, from 4 to 16. Defaults to 10.
+     */
+    cost?: pulumi.Input<number>;
+}
+
+export interface BundleVariantArgs {
+    /**
+     * The characters the variant is made of. Defaults to letters and digits.
+     */
+    charset?: pulumi.Input<string>;
+    /**
+     * How many characters the variant has. Defaults to 32.
+     */
+    length?: pulumi.Input<number>;
+}
+
+export interface CertificateSubjectArgs {
+    /**
+     * The common name (CN) of the subject.
+     */
+    commonName: pulumi.Input<string>;
+    /**
+     * The organization (O) of the subject.
+     */
+    organization?: pulumi.Input<string>;
+}
+
+export interface GeneratorArgs {
+    /**
+     * The characters a generated value is made of. Defaults to letters and digits.
+     */
+    charset?: pulumi.Input<string>;
+    /**
+     * How values are generated.
+     */
+    kind: pulumi.Input<enums.GeneratorKind>;
+    /**
+     * How many characters a generated value has. Defaults to 32.
+     */
+    length?: pulumi.Input<number>;
+    /**
+     * The most characters a petname may have, separators included.
+     */
+    maxLength?: pulumi.Input<number>;
+    /**
+     * Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+     */
+    seed?: pulumi.Input<string>;
+    /**
+     * What the words of a petname are joined with. Defaults to `-`.
+     */
+    separator?: pulumi.Input<string>;
+    /**
+     * How many words a petname has. Defaults to 2.
+     */
+    words?: pulumi.Input<number>;
+}
+
+export interface HashOptionsArgs {
+    /**
+     * Compute `argon2Hash`, an argon2id hash.
+     */
+    argon2?: pulumi.Input<inputs.Argon2OptionsArgs>;
+    /**
+     * Compute `bcryptHash`.
+     */
+    bcrypt?: pulumi.Input<inputs.BcryptOptionsArgs>;
+    /**
+     * Compute `scryptHash`.
+     */
+    scrypt?: pulumi.Input<inputs.ScryptOptionsArgs>;
+}
+
+export interface PairFieldArgs {
+    /**
+     * How to generate the value to pin. A new value is only generated when the pair rotates.
+     */
+    generator?: pulumi.Input<inputs.GeneratorArgs>;
+    /**
+     * The value to pin. Exactly one of `value` and `generator` is set.
+     */
+    value?: pulumi.Input<string>;
+}
+
+export interface ScryptOptionsArgs {
+    /**
+     * The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+     */
+    logN?: pulumi.Input<number>;
+    /**
+     * The parallelization. Defaults to 1.
+     */
+    p?: pulumi.Input<number>;
+    /**
+     * The block size. Defaults to 8.
+     */
+    r?: pulumi.Input<number>;
+}
+
+export interface SourceArgs {
+    /**
+     * The program and arguments a `command` source runs.
+     */
+    command?: pulumi.Input<pulumi.Input<string>[]>;
+    /**
+     * The kind of source.
+     */
+    kind: pulumi.Input<enums.SourceKind>;
+    /**
+     * The environment variable an `env` source reads.
+     */
+    name?: pulumi.Input<string>;
+    /**
+     * The file a `file` source reads.
+     */
+    path?: pulumi.Input<string>;
+}
+
+export interface TransformArgs {
+    /**
+     * The transform to apply.
+     */
+    kind: pulumi.Input<enums.TransformKind>;
+    /**
+     * The length a `truncate` transform keeps.
+     */
+    length?: pulumi.Input<number>;
+    /**
+     * The text a `prefix` or `suffix` transform adds.
+     */
+    value?: pulumi.Input<string>;
+}
+/**
+ * transformArgsProvideDefaults sets the appropriate defaults for TransformArgs
+ */
+export function transformArgsProvideDefaults(val: TransformArgs): TransformArgs {
+    return {
+        ...val,
+        length: (val.length) ?? 63,
+    };
+}
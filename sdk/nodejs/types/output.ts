@@ -0,0 +1,158 @@
+// *** WARNING: this file was generated by pulumi-language-nodejs. ***
+// *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import * as pulumi from "@pulumi/pulumi";
+import * as inputs from "./types/input";
+import * as outputs from "./types/output";
+import * as enums from "./types/enums";
+
+import * as utilities from "./utilities";
+
+export interface Argon2Options {
+    /**
+     * The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+     */
+    memory?: number;
+    /**
+     * The degree of parallelism, from 1 to 255. Defaults to 4.
+     */
+    threads?: number;
+    /**
+     * The number of passes over the memory, from 1 to 10. Defaults to 1.
+     */
+    time?: number;
+}
+
+export interface BcryptOptions {
+    /**
+     * The bcrypt cost, from 4 to 16. Defaults to 10.
+     */
+    cost?: number;
+}
+
+export interface CertificateSubject {
+    /**
+     * The common name (CN) of the subject.
+     */
+    commonName: string;
+    /**
+     * The organization (O) of the subject.
+     */
+    organization?: string;
+}
+
+export interface Generator {
+    /**
+     * The characters a generated value is made of. Defaults to letters and digits.
+     */
+    charset?: string;
+    /**
+     * How values are generated.
+     */
+    kind: enums.GeneratorKind;
+    /**
+     * How many characters a generated value has. Defaults to 32.
+     */
+    length?: number;
+    /**
+     * The most characters a petname may have, separators included.
+     */
+    maxLength?: number;
+    /**
+     * Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+     */
+    seed?: string;
+    /**
+     * What the words of a petname are joined with. Defaults to `-`.
+     */
+    separator?: string;
+    /**
+     * How many words a petname has. Defaults to 2.
+     */
+    words?: number;
+}
+
+export interface HashOptions {
+    /**
+     * Compute `argon2Hash`, an argon2id hash.
+     */
+    argon2?: outputs.Argon2Options;
+    /**
+     * Compute `bcryptHash`.
+     */
+    bcrypt?: outputs.BcryptOptions;
+    /**
+     * Compute `scryptHash`.
+     */
+    scrypt?: outputs.ScryptOptions;
+}
+
+export interface PairField {
+    /**
+     * How to generate the value to pin. A new value is only generated when the pair rotates.
+     */
+    generator?: outputs.Generator;
+    /**
+     * The value to pin. Exactly one of `value` and `generator` is set.
+     */
+    value?: string;
+}
+
+export interface ScryptOptions {
+    /**
+     * The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+     */
+    logN?: number;
+    /**
+     * The parallelization. Defaults to 1.
+     */
+    p?: number;
+    /**
+     * The block size. Defaults to 8.
+     */
+    r?: number;
+}
+
+export interface Source {
+    /**
+     * The program and arguments a `command` source runs.
+     */
+    command?: string[];
+    /**
+     * The kind of source.
+     */
+    kind: enums.SourceKind;
+    /**
+     * The environment variable an `env` source reads.
+     */
+    name?: string;
+    /**
+     * The file a `file` source reads.
+     */
+    path?: string;
+}
+
+export interface Transform {
+    /**
+     * The transform to apply.
+     */
+    kind: enums.TransformKind;
+    /**
+     * The length a `truncate` transform keeps.
+     */
+    length?: number;
+    /**
+     * The text a `prefix` or `suffix` transform adds.
+     */
+    value?: string;
+}
+/**
+ * transformProvideDefaults sets the appropriate defaults for Transform
+ */
+export function transformProvideDefaults(val: Transform): Transform {
+    return {
+        ...val,
+        length: (val.length) ?? 63,
+    };
+}
+
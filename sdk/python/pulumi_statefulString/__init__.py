@@ -5,8 +5,27 @@
 from . import _utilities
 import typing
 # Export this package's modules as members:
+from ._enums import *
+from .get_rotation_group_trigger import *
+from .get_shared_stateful_string import *
+from .helpers import *
 from .provider import *
+from .secret_bundle import *
+from .shared_stateful_string import *
+from .stateful_certificate import *
+from .stateful_key_pair import *
 from .stateful_string import *
+from .stateful_string_pair import *
+from ._inputs import *
+from . import outputs
+
+# Make subpackages available:
+if typing.TYPE_CHECKING:
+    import pulumi_statefulString.config as __config
+    config = __config
+else:
+    config = _utilities.lazy_import('pulumi_statefulString.config')
+
 _utilities.register(
     resource_modules="""
 [
@@ -15,7 +34,12 @@ _utilities.register(
   "mod": "index",
   "fqn": "pulumi_statefulString",
   "classes": {
-   "statefulString:index:StatefulString": "StatefulString"
+   "statefulString:index:SecretBundle": "SecretBundle",
+   "statefulString:index:SharedStatefulString": "SharedStatefulString",
+   "statefulString:index:StatefulCertificate": "StatefulCertificate",
+   "statefulString:index:StatefulKeyPair": "StatefulKeyPair",
+   "statefulString:index:StatefulString": "StatefulString",
+   "statefulString:index:StatefulStringPair": "StatefulStringPair"
   }
  }
 ]
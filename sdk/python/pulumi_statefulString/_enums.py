@@ -0,0 +1,136 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+from enum import Enum
+
+__all__ = [
+    'ECDSACurve',
+    'ExpiryPolicy',
+    'GeneratorKind',
+    'KeyAlgorithm',
+    'RotationMode',
+    'SourceKind',
+    'TransformKind',
+]
+
+
+class ECDSACurve(str, Enum):
+    P256 = "P256"
+    """
+    NIST P-256.
+    """
+    P384 = "P384"
+    """
+    NIST P-384.
+    """
+    P521 = "P521"
+    """
+    NIST P-521.
+    """
+
+
+class ExpiryPolicy(str, Enum):
+    WARN = "warn"
+    """
+    Emit a warning on every diff. This is the default.
+    """
+    FAIL = "fail"
+    """
+    Fail the update until the string is rotated.
+    """
+    ROTATE = "rotate"
+    """
+    Pin the current string input as if a trigger changed.
+    """
+
+
+class GeneratorKind(str, Enum):
+    RANDOM = "random"
+    """
+    Draw `length` characters uniformly at random from `charset`.
+    """
+    DERIVE = "derive"
+    """
+    Compute `length` characters from `charset` with an HMAC-SHA256 of the triggers, keyed by the provider's `deriveSeed`. The same seed and triggers always derive the same value.
+    """
+    PETNAME = "petname"
+    """
+    Join `words` words from an embedded wordlist with `separator`: adjectives followed by a noun, such as `brave-otter`.
+    """
+
+
+class KeyAlgorithm(str, Enum):
+    ED25519 = "ed25519"
+    """
+    An Ed25519 key.
+    """
+    RSA = "rsa"
+    """
+    An RSA key of `rsaBits` bits.
+    """
+    ECDSA = "ecdsa"
+    """
+    An ECDSA key on `ecdsaCurve`.
+    """
+
+
+class RotationMode(str, Enum):
+    ROTATE_ON_TRIGGER_CHANGE = "rotateOnTriggerChange"
+    """
+    Pin the string whenever a trigger is added, removed or changed. This is the default.
+    """
+    ROTATE_ON_STRING_CHANGE = "rotateOnStringChange"
+    """
+    Pin the string whenever it or a trigger changes.
+    """
+    EXPLICIT = "explicit"
+    """
+    Pin the string only when the forceRotate nonce changes.
+    """
+
+
+class SourceKind(str, Enum):
+    ENV = "env"
+    """
+    Read the environment variable `name` of the provider process.
+    """
+    FILE = "file"
+    """
+    Read the local file at `path`.
+    """
+    COMMAND = "command"
+    """
+    Run `command` and read its standard output.
+    """
+
+
+class TransformKind(str, Enum):
+    LOWER = "lower"
+    """
+    Lowercase the value.
+    """
+    UPPER = "upper"
+    """
+    Uppercase the value.
+    """
+    TRUNCATE = "truncate"
+    """
+    Keep at most `length` characters (63 by default).
+    """
+    SLUGIFY = "slugify"
+    """
+    Reduce the value to lowercase letters, digits and single dashes.
+    """
+    PREFIX = "prefix"
+    """
+    Prepend `value`.
+    """
+    SUFFIX = "suffix"
+    """
+    Append `value`.
+    """
+    BASE64 = "base64"
+    """
+    Standard base64 encode the value.
+    """
@@ -0,0 +1,573 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._enums import *
+
+__all__ = [
+    'Argon2OptionsArgs',
+    'BcryptOptionsArgs',
+    'BundleVariantArgs',
+    'CertificateSubjectArgs',
+    'GeneratorArgs',
+    'HashOptionsArgs',
+    'PairFieldArgs',
+    'ScryptOptionsArgs',
+    'SourceArgs',
+    'TransformArgs',
+]
+
+@pulumi.input_type
+class Argon2OptionsArgs:
+    def __init__(__self__, *,
+                 memory: Optional[pulumi.Input[int]] = None,
+                 threads: Optional[pulumi.Input[int]] = None,
+                 time: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input[int] memory: The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+        :param pulumi.Input[int] threads: The degree of parallelism, from 1 to 255. Defaults to 4.
+        :param pulumi.Input[int] time: The number of passes over the memory, from 1 to 10. Defaults to 1.
+        """
+        if memory is not None:
+            pulumi.set(__self__, "memory", memory)
+        if threads is not None:
+            pulumi.set(__self__, "threads", threads)
+        if time is not None:
+            pulumi.set(__self__, "time", time)
+
+    @property
+    @pulumi.getter
+    def memory(self) -> Optional[pulumi.Input[int]]:
+        """
+        The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+        """
+        return pulumi.get(self, "memory")
+
+    @memory.setter
+    def memory(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "memory", value)
+
+    @property
+    @pulumi.getter
+    def threads(self) -> Optional[pulumi.Input[int]]:
+        """
+        The degree of parallelism, from 1 to 255. Defaults to 4.
+        """
+        return pulumi.get(self, "threads")
+
+    @threads.setter
+    def threads(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "threads", value)
+
+    @property
+    @pulumi.getter
+    def time(self) -> Optional[pulumi.Input[int]]:
+        """
+        The number of passes over the memory, from 1 to 10. Defaults to 1.
+        """
+        return pulumi.get(self, "time")
+
+    @time.setter
+    def time(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "time", value)
+
+
+@pulumi.input_type
+class BcryptOptionsArgs:
+    def __init__(__self__, *,
+                 cost: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input[int] cost: The bcrypt cost, from 4 to 16. Defaults to 10.
+        """
+        if cost is not None:
+            pulumi.set(__self__, "cost", cost)
+
+    @property
+    @pulumi.getter
+    def cost(self) -> Optional[pulumi.Input[int]]:
+        """
+        The bcrypt cost, from 4 to 16. Defaults to 10.
+        """
+        return pulumi.get(self, "cost")
+
+    @cost.setter
+    def cost(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "cost", value)
+
+
+@pulumi.input_type
+class BundleVariantArgs:
+    def __init__(__self__, *,
+                 charset: Optional[pulumi.Input[str]] = None,
+                 length: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input[str] charset: The characters the variant is made of. Defaults to letters and digits.
+        :param pulumi.Input[int] length: How many characters the variant has. Defaults to 32.
+        """
+        if charset is not None:
+            pulumi.set(__self__, "charset", charset)
+        if length is not None:
+            pulumi.set(__self__, "length", length)
+
+    @property
+    @pulumi.getter
+    def charset(self) -> Optional[pulumi.Input[str]]:
+        """
+        The characters the variant is made of. Defaults to letters and digits.
+        """
+        return pulumi.get(self, "charset")
+
+    @charset.setter
+    def charset(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "charset", value)
+
+    @property
+    @pulumi.getter
+    def length(self) -> Optional[pulumi.Input[int]]:
+        """
+        How many characters the variant has. Defaults to 32.
+        """
+        return pulumi.get(self, "length")
+
+    @length.setter
+    def length(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "length", value)
+
+
+@pulumi.input_type
+class CertificateSubjectArgs:
+    def __init__(__self__, *,
+                 common_name: pulumi.Input[str],
+                 organization: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input[str] common_name: The common name (CN) of the subject.
+        :param pulumi.Input[str] organization: The organization (O) of the subject.
+        """
+        pulumi.set(__self__, "common_name", common_name)
+        if organization is not None:
+            pulumi.set(__self__, "organization", organization)
+
+    @property
+    @pulumi.getter(name="commonName")
+    def common_name(self) -> pulumi.Input[str]:
+        """
+        The common name (CN) of the subject.
+        """
+        return pulumi.get(self, "common_name")
+
+    @common_name.setter
+    def common_name(self, value: pulumi.Input[str]):
+        pulumi.set(self, "common_name", value)
+
+    @property
+    @pulumi.getter
+    def organization(self) -> Optional[pulumi.Input[str]]:
+        """
+        The organization (O) of the subject.
+        """
+        return pulumi.get(self, "organization")
+
+    @organization.setter
+    def organization(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "organization", value)
+
+
+@pulumi.input_type
+class GeneratorArgs:
+    def __init__(__self__, *,
+                 kind: pulumi.Input['GeneratorKind'],
+                 charset: Optional[pulumi.Input[str]] = None,
+                 length: Optional[pulumi.Input[int]] = None,
+                 max_length: Optional[pulumi.Input[int]] = None,
+                 seed: Optional[pulumi.Input[str]] = None,
+                 separator: Optional[pulumi.Input[str]] = None,
+                 words: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input['GeneratorKind'] kind: How values are generated.
+        :param pulumi.Input[str] charset: The characters a generated value is made of. Defaults to letters and digits.
+        :param pulumi.Input[int] length: How many characters a generated value has. Defaults to 32.
+        :param pulumi.Input[int] max_length: The most characters a petname may have, separators included.
+        :param pulumi.Input[str] seed: Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+        :param pulumi.Input[str] separator: What the words of a petname are joined with. Defaults to `-`.
+        :param pulumi.Input[int] words: How many words a petname has. Defaults to 2.
+        """
+        pulumi.set(__self__, "kind", kind)
+        if charset is not None:
+            pulumi.set(__self__, "charset", charset)
+        if length is not None:
+            pulumi.set(__self__, "length", length)
+        if max_length is not None:
+            pulumi.set(__self__, "max_length", max_length)
+        if seed is not None:
+            pulumi.set(__self__, "seed", seed)
+        if separator is not None:
+            pulumi.set(__self__, "separator", separator)
+        if words is not None:
+            pulumi.set(__self__, "words", words)
+
+    @property
+    @pulumi.getter
+    def kind(self) -> pulumi.Input['GeneratorKind']:
+        """
+        How values are generated.
+        """
+        return pulumi.get(self, "kind")
+
+    @kind.setter
+    def kind(self, value: pulumi.Input['GeneratorKind']):
+        pulumi.set(self, "kind", value)
+
+    @property
+    @pulumi.getter
+    def charset(self) -> Optional[pulumi.Input[str]]:
+        """
+        The characters a generated value is made of. Defaults to letters and digits.
+        """
+        return pulumi.get(self, "charset")
+
+    @charset.setter
+    def charset(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "charset", value)
+
+    @property
+    @pulumi.getter
+    def length(self) -> Optional[pulumi.Input[int]]:
+        """
+        How many characters a generated value has. Defaults to 32.
+        """
+        return pulumi.get(self, "length")
+
+    @length.setter
+    def length(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "length", value)
+
+    @property
+    @pulumi.getter(name="maxLength")
+    def max_length(self) -> Optional[pulumi.Input[int]]:
+        """
+        The most characters a petname may have, separators included.
+        """
+        return pulumi.get(self, "max_length")
+
+    @max_length.setter
+    def max_length(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_length", value)
+
+    @property
+    @pulumi.getter
+    def seed(self) -> Optional[pulumi.Input[str]]:
+        """
+        Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+        """
+        return pulumi.get(self, "seed")
+
+    @seed.setter
+    def seed(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "seed", value)
+
+    @property
+    @pulumi.getter
+    def separator(self) -> Optional[pulumi.Input[str]]:
+        """
+        What the words of a petname are joined with. Defaults to `-`.
+        """
+        return pulumi.get(self, "separator")
+
+    @separator.setter
+    def separator(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "separator", value)
+
+    @property
+    @pulumi.getter
+    def words(self) -> Optional[pulumi.Input[int]]:
+        """
+        How many words a petname has. Defaults to 2.
+        """
+        return pulumi.get(self, "words")
+
+    @words.setter
+    def words(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "words", value)
+
+
+@pulumi.input_type
+class HashOptionsArgs:
+    def __init__(__self__, *,
+                 argon2: Optional[pulumi.Input['Argon2OptionsArgs']] = None,
+                 bcrypt: Optional[pulumi.Input['BcryptOptionsArgs']] = None,
+                 scrypt: Optional[pulumi.Input['ScryptOptionsArgs']] = None):
+        """
+        :param pulumi.Input['Argon2OptionsArgs'] argon2: Compute `argon2Hash`, an argon2id hash.
+        :param pulumi.Input['BcryptOptionsArgs'] bcrypt: Compute `bcryptHash`.
+        :param pulumi.Input['ScryptOptionsArgs'] scrypt: Compute `scryptHash`.
+        """
+        if argon2 is not None:
+            pulumi.set(__self__, "argon2", argon2)
+        if bcrypt is not None:
+            pulumi.set(__self__, "bcrypt", bcrypt)
+        if scrypt is not None:
+            pulumi.set(__self__, "scrypt", scrypt)
+
+    @property
+    @pulumi.getter
+    def argon2(self) -> Optional[pulumi.Input['Argon2OptionsArgs']]:
+        """
+        Compute `argon2Hash`, an argon2id hash.
+        """
+        return pulumi.get(self, "argon2")
+
+    @argon2.setter
+    def argon2(self, value: Optional[pulumi.Input['Argon2OptionsArgs']]):
+        pulumi.set(self, "argon2", value)
+
+    @property
+    @pulumi.getter
+    def bcrypt(self) -> Optional[pulumi.Input['BcryptOptionsArgs']]:
+        """
+        Compute `bcryptHash`.
+        """
+        return pulumi.get(self, "bcrypt")
+
+    @bcrypt.setter
+    def bcrypt(self, value: Optional[pulumi.Input['BcryptOptionsArgs']]):
+        pulumi.set(self, "bcrypt", value)
+
+    @property
+    @pulumi.getter
+    def scrypt(self) -> Optional[pulumi.Input['ScryptOptionsArgs']]:
+        """
+        Compute `scryptHash`.
+        """
+        return pulumi.get(self, "scrypt")
+
+    @scrypt.setter
+    def scrypt(self, value: Optional[pulumi.Input['ScryptOptionsArgs']]):
+        pulumi.set(self, "scrypt", value)
+
+
+@pulumi.input_type
+class PairFieldArgs:
+    def __init__(__self__, *,
+                 generator: Optional[pulumi.Input['GeneratorArgs']] = None,
+                 value: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input['GeneratorArgs'] generator: How to generate the value to pin. A new value is only generated when the pair rotates.
+        :param pulumi.Input[str] value: The value to pin. Exactly one of `value` and `generator` is set.
+        """
+        if generator is not None:
+            pulumi.set(__self__, "generator", generator)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def generator(self) -> Optional[pulumi.Input['GeneratorArgs']]:
+        """
+        How to generate the value to pin. A new value is only generated when the pair rotates.
+        """
+        return pulumi.get(self, "generator")
+
+    @generator.setter
+    def generator(self, value: Optional[pulumi.Input['GeneratorArgs']]):
+        pulumi.set(self, "generator", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[pulumi.Input[str]]:
+        """
+        The value to pin. Exactly one of `value` and `generator` is set.
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "value", value)
+
+
+@pulumi.input_type
+class ScryptOptionsArgs:
+    def __init__(__self__, *,
+                 log_n: Optional[pulumi.Input[int]] = None,
+                 p: Optional[pulumi.Input[int]] = None,
+                 r: Optional[pulumi.Input[int]] = None):
+        """
+        :param pulumi.Input[int] log_n: The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+        :param pulumi.Input[int] p: The parallelization. Defaults to 1.
+        :param pulumi.Input[int] r: The block size. Defaults to 8.
+        """
+        if log_n is not None:
+            pulumi.set(__self__, "log_n", log_n)
+        if p is not None:
+            pulumi.set(__self__, "p", p)
+        if r is not None:
+            pulumi.set(__self__, "r", r)
+
+    @property
+    @pulumi.getter(name="logN")
+    def log_n(self) -> Optional[pulumi.Input[int]]:
+        """
+        The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+        """
+        return pulumi.get(self, "log_n")
+
+    @log_n.setter
+    def log_n(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "log_n", value)
+
+    @property
+    @pulumi.getter
+    def p(self) -> Optional[pulumi.Input[int]]:
+        """
+        The parallelization. Defaults to 1.
+        """
+        return pulumi.get(self, "p")
+
+    @p.setter
+    def p(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "p", value)
+
+    @property
+    @pulumi.getter
+    def r(self) -> Optional[pulumi.Input[int]]:
+        """
+        The block size. Defaults to 8.
+        """
+        return pulumi.get(self, "r")
+
+    @r.setter
+    def r(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "r", value)
+
+
+@pulumi.input_type
+class SourceArgs:
+    def __init__(__self__, *,
+                 kind: pulumi.Input['SourceKind'],
+                 command: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 name: Optional[pulumi.Input[str]] = None,
+                 path: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input['SourceKind'] kind: The kind of source.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] command: The program and arguments a `command` source runs.
+        :param pulumi.Input[str] name: The environment variable an `env` source reads.
+        :param pulumi.Input[str] path: The file a `file` source reads.
+        """
+        pulumi.set(__self__, "kind", kind)
+        if command is not None:
+            pulumi.set(__self__, "command", command)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+
+    @property
+    @pulumi.getter
+    def kind(self) -> pulumi.Input['SourceKind']:
+        """
+        The kind of source.
+        """
+        return pulumi.get(self, "kind")
+
+    @kind.setter
+    def kind(self, value: pulumi.Input['SourceKind']):
+        pulumi.set(self, "kind", value)
+
+    @property
+    @pulumi.getter
+    def command(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The program and arguments a `command` source runs.
+        """
+        return pulumi.get(self, "command")
+
+    @command.setter
+    def command(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "command", value)
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[pulumi.Input[str]]:
+        """
+        The environment variable an `env` source reads.
+        """
+        return pulumi.get(self, "name")
+
+    @name.setter
+    def name(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "name", value)
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[pulumi.Input[str]]:
+        """
+        The file a `file` source reads.
+        """
+        return pulumi.get(self, "path")
+
+    @path.setter
+    def path(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "path", value)
+
+
+@pulumi.input_type
+class TransformArgs:
+    def __init__(__self__, *,
+                 kind: pulumi.Input['TransformKind'],
+                 length: Optional[pulumi.Input[int]] = None,
+                 value: Optional[pulumi.Input[str]] = None):
+        """
+        :param pulumi.Input['TransformKind'] kind: The transform to apply.
+        :param pulumi.Input[int] length: The length a `truncate` transform keeps.
+        :param pulumi.Input[str] value: The text a `prefix` or `suffix` transform adds.
+        """
+        pulumi.set(__self__, "kind", kind)
+        if length is None:
+            length = 63
+        if length is not None:
+            pulumi.set(__self__, "length", length)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def kind(self) -> pulumi.Input['TransformKind']:
+        """
+        The transform to apply.
+        """
+        return pulumi.get(self, "kind")
+
+    @kind.setter
+    def kind(self, value: pulumi.Input['TransformKind']):
+        pulumi.set(self, "kind", value)
+
+    @property
+    @pulumi.getter
+    def length(self) -> Optional[pulumi.Input[int]]:
+        """
+        The length a `truncate` transform keeps.
+        """
+        return pulumi.get(self, "length")
+
+    @length.setter
+    def length(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "length", value)
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[pulumi.Input[str]]:
+        """
+        The text a `prefix` or `suffix` transform adds.
+        """
+        return pulumi.get(self, "value")
+
+    @value.setter
+    def value(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "value", value)
+
+
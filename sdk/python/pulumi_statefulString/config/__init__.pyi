@@ -0,0 +1,31 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+archiveDir: Optional[str]
+"""
+The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+"""
+
+deriveSeed: Optional[str]
+"""
+The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+"""
+
+rotationManifest: Optional[str]
+"""
+The path of the JSON file rotation groups are read from.
+"""
+
+sharedStoreDir: Optional[str]
+"""
+The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+"""
+
@@ -0,0 +1,45 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+import types
+
+__config__ = pulumi.Config('statefulString')
+
+
+class _ExportableConfig(types.ModuleType):
+    @property
+    def archive_dir(self) -> Optional[str]:
+        """
+        The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        """
+        return __config__.get('archiveDir')
+
+    @property
+    def derive_seed(self) -> Optional[str]:
+        """
+        The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        """
+        return __config__.get('deriveSeed')
+
+    @property
+    def rotation_manifest(self) -> Optional[str]:
+        """
+        The path of the JSON file rotation groups are read from.
+        """
+        return __config__.get('rotationManifest')
+
+    @property
+    def shared_store_dir(self) -> Optional[str]:
+        """
+        The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+        """
+        return __config__.get('sharedStoreDir')
+
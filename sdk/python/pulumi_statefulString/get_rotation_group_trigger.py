@@ -0,0 +1,70 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'GetRotationGroupTriggerResult',
+    'AwaitableGetRotationGroupTriggerResult',
+    'get_rotation_group_trigger',
+    'get_rotation_group_trigger_output',
+]
+
+@pulumi.output_type
+class GetRotationGroupTriggerResult:
+    def __init__(__self__, trigger=None):
+        if trigger and not isinstance(trigger, str):
+            raise TypeError("Expected argument 'trigger' to be a str")
+        pulumi.set(__self__, "trigger", trigger)
+
+    @property
+    @pulumi.getter
+    def trigger(self) -> str:
+        """
+        The trigger value of the group, which changes whenever its manifest entry does.
+        """
+        return pulumi.get(self, "trigger")
+
+
+class AwaitableGetRotationGroupTriggerResult(GetRotationGroupTriggerResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetRotationGroupTriggerResult(
+            trigger=self.trigger)
+
+
+def get_rotation_group_trigger(group: Optional[str] = None,
+                               opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetRotationGroupTriggerResult:
+    """
+    Computes the shared trigger value of a rotation group from the provider's rotation manifest, for use as a trigger on resources outside the group.
+
+
+    :param str group: The name of the group in the rotation manifest.
+    """
+    __args__ = dict()
+    __args__['group'] = group
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('statefulString:index:getRotationGroupTrigger', __args__, opts=opts, typ=GetRotationGroupTriggerResult).value
+
+    return AwaitableGetRotationGroupTriggerResult(
+        trigger=pulumi.get(__ret__, 'trigger'))
+
+
+@_utilities.lift_output_func(get_rotation_group_trigger)
+def get_rotation_group_trigger_output(group: Optional[pulumi.Input[str]] = None,
+                                      opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetRotationGroupTriggerResult]:
+    """
+    Computes the shared trigger value of a rotation group from the provider's rotation manifest, for use as a trigger on resources outside the group.
+
+
+    :param str group: The name of the group in the rotation manifest.
+    """
+    ...
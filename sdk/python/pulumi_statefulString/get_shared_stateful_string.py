@@ -0,0 +1,96 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = [
+    'GetSharedStatefulStringResult',
+    'AwaitableGetSharedStatefulStringResult',
+    'get_shared_stateful_string',
+    'get_shared_stateful_string_output',
+]
+
+@pulumi.output_type
+class GetSharedStatefulStringResult:
+    def __init__(__self__, string=None, triggers=None, updated_at=None):
+        if string and not isinstance(string, str):
+            raise TypeError("Expected argument 'string' to be a str")
+        pulumi.set(__self__, "string", string)
+        if triggers and not isinstance(triggers, dict):
+            raise TypeError("Expected argument 'triggers' to be a dict")
+        pulumi.set(__self__, "triggers", triggers)
+        if updated_at and not isinstance(updated_at, str):
+            raise TypeError("Expected argument 'updated_at' to be a str")
+        pulumi.set(__self__, "updated_at", updated_at)
+
+    @property
+    @pulumi.getter
+    def string(self) -> str:
+        """
+        The published string.
+        """
+        return pulumi.get(self, "string")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[Mapping[str, Any]]:
+        """
+        The triggers the string was last pinned with.
+        """
+        return pulumi.get(self, "triggers")
+
+    @property
+    @pulumi.getter(name="updatedAt")
+    def updated_at(self) -> str:
+        """
+        The RFC 3339 time at which the record was last published.
+        """
+        return pulumi.get(self, "updated_at")
+
+
+class AwaitableGetSharedStatefulStringResult(GetSharedStatefulStringResult):
+    # pylint: disable=using-constant-test
+    def __await__(self):
+        if False:
+            yield self
+        return GetSharedStatefulStringResult(
+            string=self.string,
+            triggers=self.triggers,
+            updated_at=self.updated_at)
+
+
+def get_shared_stateful_string(key: Optional[str] = None,
+                               opts: Optional[pulumi.InvokeOptions] = None) -> AwaitableGetSharedStatefulStringResult:
+    """
+    Reads a string published by a SharedStatefulString, possibly from another stack.
+
+
+    :param str key: The name of the record in the shared store.
+    """
+    __args__ = dict()
+    __args__['key'] = key
+    opts = pulumi.InvokeOptions.merge(_utilities.get_invoke_opts_defaults(), opts)
+    __ret__ = pulumi.runtime.invoke('statefulString:index:getSharedStatefulString', __args__, opts=opts, typ=GetSharedStatefulStringResult).value
+
+    return AwaitableGetSharedStatefulStringResult(
+        string=pulumi.get(__ret__, 'string'),
+        triggers=pulumi.get(__ret__, 'triggers'),
+        updated_at=pulumi.get(__ret__, 'updated_at'))
+
+
+@_utilities.lift_output_func(get_shared_stateful_string)
+def get_shared_stateful_string_output(key: Optional[pulumi.Input[str]] = None,
+                                      opts: Optional[pulumi.InvokeOptions] = None) -> pulumi.Output[GetSharedStatefulStringResult]:
+    """
+    Reads a string published by a SharedStatefulString, possibly from another stack.
+
+
+    :param str key: The name of the record in the shared store.
+    """
+    ...
@@ -0,0 +1,96 @@
+# Copyright 2016-2023, Pulumi Corporation.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+# This file is written by hand. The Makefile lays it over the generated SDK.
+
+import hashlib
+import os
+import re
+from typing import Any, Dict, Mapping, Optional
+
+import pulumi
+
+from .stateful_string import StatefulString, StatefulStringArgs
+
+__all__ = [
+    'triggers_from_files',
+    'triggers_from_outputs',
+    'trigger_on_image_digest',
+    'merge_triggers',
+    'pinned',
+]
+
+Triggers = Dict[str, pulumi.Input[Any]]
+
+
+def triggers_from_files(*paths: str) -> Triggers:
+    """
+    Returns triggers that change whenever the content of one of the files changes. Each file maps
+    to the SHA-256 of its content, so the content itself never reaches the state. The key is the
+    base name of the file with every character other than a letter, digit, `_` or `-` replaced by
+    `_`, so that it does not depend on where the program runs; two files with the same key are an
+    error.
+    """
+    triggers: Triggers = {}
+    for path in paths:
+        key = _file_trigger_key(path)
+        if key in triggers:
+            raise ValueError(f'{path}: another file already uses the trigger key "{key}"')
+        with open(path, 'rb') as f:
+            triggers[key] = hashlib.sha256(f.read()).hexdigest()
+    return triggers
+
+
+def _file_trigger_key(path: str) -> str:
+    return re.sub(r'[^A-Za-z0-9_-]', '_', os.path.basename(path))
+
+
+def triggers_from_outputs(outputs: Mapping[str, pulumi.Input[Any]]) -> Triggers:
+    """
+    Returns triggers that change whenever one of the outputs resolves to a new value.
+    """
+    return dict(outputs)
+
+
+def trigger_on_image_digest(image: pulumi.Input[str]) -> Triggers:
+    """
+    Returns a trigger that changes whenever the image is rebuilt. A reference pinned by digest,
+    such as `repo:tag@sha256:...`, triggers on its digest alone; any other reference triggers on
+    the whole reference.
+    """
+    return {'imageDigest': pulumi.Output.from_input(image).apply(_image_digest)}
+
+
+def _image_digest(image: str) -> str:
+    return image.rpartition('@')[2]
+
+
+def merge_triggers(*sets: Mapping[str, pulumi.Input[Any]]) -> Triggers:
+    """
+    Combines several sets of triggers into one. Later sets win when a key appears more than once.
+    """
+    triggers: Triggers = {}
+    for s in sets:
+        triggers.update(s)
+    return triggers
+
+
+def pinned(resource_name: str,
+           value: pulumi.Input[str],
+           triggers: pulumi.Input[Mapping[str, Any]],
+           opts: Optional[pulumi.ResourceOptions] = None) -> StatefulString:
+    """
+    Shorthand for a StatefulString that pins `value` until one of `triggers` changes.
+    """
+    return StatefulString(resource_name, StatefulStringArgs(string=value, triggers=triggers), opts)
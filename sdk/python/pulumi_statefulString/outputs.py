@@ -0,0 +1,473 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._enums import *
+
+__all__ = [
+    'Argon2Options',
+    'BcryptOptions',
+    'CertificateSubject',
+    'Generator',
+    'HashOptions',
+    'PairField',
+    'ScryptOptions',
+    'Source',
+    'Transform',
+]
+
+@pulumi.output_type
+class Argon2Options(dict):
+    def __init__(__self__, *,
+                 memory: Optional[int] = None,
+                 threads: Optional[int] = None,
+                 time: Optional[int] = None):
+        """
+        :param int memory: The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+        :param int threads: The degree of parallelism, from 1 to 255. Defaults to 4.
+        :param int time: The number of passes over the memory, from 1 to 10. Defaults to 1.
+        """
+        if memory is not None:
+            pulumi.set(__self__, "memory", memory)
+        if threads is not None:
+            pulumi.set(__self__, "threads", threads)
+        if time is not None:
+            pulumi.set(__self__, "time", time)
+
+    @property
+    @pulumi.getter
+    def memory(self) -> Optional[int]:
+        """
+        The memory to use, in KiB, up to 4194304 (4 GiB). Defaults to 65536.
+        """
+        return pulumi.get(self, "memory")
+
+    @property
+    @pulumi.getter
+    def threads(self) -> Optional[int]:
+        """
+        The degree of parallelism, from 1 to 255. Defaults to 4.
+        """
+        return pulumi.get(self, "threads")
+
+    @property
+    @pulumi.getter
+    def time(self) -> Optional[int]:
+        """
+        The number of passes over the memory, from 1 to 10. Defaults to 1.
+        """
+        return pulumi.get(self, "time")
+
+
+@pulumi.output_type
+class BcryptOptions(dict):
+    def __init__(__self__, *,
+                 cost: Optional[int] = None):
+        """
+        :param int cost: The bcrypt cost, from 4 to 16. Defaults to 10.
+        """
+        if cost is not None:
+            pulumi.set(__self__, "cost", cost)
+
+    @property
+    @pulumi.getter
+    def cost(self) -> Optional[int]:
+        """
+        The bcrypt cost, from 4 to 16. Defaults to 10.
+        """
+        return pulumi.get(self, "cost")
+
+
+@pulumi.output_type
+class CertificateSubject(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "commonName":
+            suggest = "common_name"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in CertificateSubject. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        CertificateSubject.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        CertificateSubject.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 common_name: str,
+                 organization: Optional[str] = None):
+        """
+        :param str common_name: The common name (CN) of the subject.
+        :param str organization: The organization (O) of the subject.
+        """
+        pulumi.set(__self__, "common_name", common_name)
+        if organization is not None:
+            pulumi.set(__self__, "organization", organization)
+
+    @property
+    @pulumi.getter(name="commonName")
+    def common_name(self) -> str:
+        """
+        The common name (CN) of the subject.
+        """
+        return pulumi.get(self, "common_name")
+
+    @property
+    @pulumi.getter
+    def organization(self) -> Optional[str]:
+        """
+        The organization (O) of the subject.
+        """
+        return pulumi.get(self, "organization")
+
+
+@pulumi.output_type
+class Generator(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "maxLength":
+            suggest = "max_length"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in Generator. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        Generator.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        Generator.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 kind: 'GeneratorKind',
+                 charset: Optional[str] = None,
+                 length: Optional[int] = None,
+                 max_length: Optional[int] = None,
+                 seed: Optional[str] = None,
+                 separator: Optional[str] = None,
+                 words: Optional[int] = None):
+        """
+        :param 'GeneratorKind' kind: How values are generated.
+        :param str charset: The characters a generated value is made of. Defaults to letters and digits.
+        :param int length: How many characters a generated value has. Defaults to 32.
+        :param int max_length: The most characters a petname may have, separators included.
+        :param str seed: Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+        :param str separator: What the words of a petname are joined with. Defaults to `-`.
+        :param int words: How many words a petname has. Defaults to 2.
+        """
+        pulumi.set(__self__, "kind", kind)
+        if charset is not None:
+            pulumi.set(__self__, "charset", charset)
+        if length is not None:
+            pulumi.set(__self__, "length", length)
+        if max_length is not None:
+            pulumi.set(__self__, "max_length", max_length)
+        if seed is not None:
+            pulumi.set(__self__, "seed", seed)
+        if separator is not None:
+            pulumi.set(__self__, "separator", separator)
+        if words is not None:
+            pulumi.set(__self__, "words", words)
+
+    @property
+    @pulumi.getter
+    def kind(self) -> 'GeneratorKind':
+        """
+        How values are generated.
+        """
+        return pulumi.get(self, "kind")
+
+    @property
+    @pulumi.getter
+    def charset(self) -> Optional[str]:
+        """
+        The characters a generated value is made of. Defaults to letters and digits.
+        """
+        return pulumi.get(self, "charset")
+
+    @property
+    @pulumi.getter
+    def length(self) -> Optional[int]:
+        """
+        How many characters a generated value has. Defaults to 32.
+        """
+        return pulumi.get(self, "length")
+
+    @property
+    @pulumi.getter(name="maxLength")
+    def max_length(self) -> Optional[int]:
+        """
+        The most characters a petname may have, separators included.
+        """
+        return pulumi.get(self, "max_length")
+
+    @property
+    @pulumi.getter
+    def seed(self) -> Optional[str]:
+        """
+        Seeds a `random` or `petname` generator so that the same seed and triggers always generate the same value, such as in tests.
+        """
+        return pulumi.get(self, "seed")
+
+    @property
+    @pulumi.getter
+    def separator(self) -> Optional[str]:
+        """
+        What the words of a petname are joined with. Defaults to `-`.
+        """
+        return pulumi.get(self, "separator")
+
+    @property
+    @pulumi.getter
+    def words(self) -> Optional[int]:
+        """
+        How many words a petname has. Defaults to 2.
+        """
+        return pulumi.get(self, "words")
+
+
+@pulumi.output_type
+class HashOptions(dict):
+    def __init__(__self__, *,
+                 argon2: Optional['outputs.Argon2Options'] = None,
+                 bcrypt: Optional['outputs.BcryptOptions'] = None,
+                 scrypt: Optional['outputs.ScryptOptions'] = None):
+        """
+        :param 'Argon2Options' argon2: Compute `argon2Hash`, an argon2id hash.
+        :param 'BcryptOptions' bcrypt: Compute `bcryptHash`.
+        :param 'ScryptOptions' scrypt: Compute `scryptHash`.
+        """
+        if argon2 is not None:
+            pulumi.set(__self__, "argon2", argon2)
+        if bcrypt is not None:
+            pulumi.set(__self__, "bcrypt", bcrypt)
+        if scrypt is not None:
+            pulumi.set(__self__, "scrypt", scrypt)
+
+    @property
+    @pulumi.getter
+    def argon2(self) -> Optional['outputs.Argon2Options']:
+        """
+        Compute `argon2Hash`, an argon2id hash.
+        """
+        return pulumi.get(self, "argon2")
+
+    @property
+    @pulumi.getter
+    def bcrypt(self) -> Optional['outputs.BcryptOptions']:
+        """
+        Compute `bcryptHash`.
+        """
+        return pulumi.get(self, "bcrypt")
+
+    @property
+    @pulumi.getter
+    def scrypt(self) -> Optional['outputs.ScryptOptions']:
+        """
+        Compute `scryptHash`.
+        """
+        return pulumi.get(self, "scrypt")
+
+
+@pulumi.output_type
+class PairField(dict):
+    def __init__(__self__, *,
+                 generator: Optional['outputs.Generator'] = None,
+                 value: Optional[str] = None):
+        """
+        :param 'Generator' generator: How to generate the value to pin. A new value is only generated when the pair rotates.
+        :param str value: The value to pin. Exactly one of `value` and `generator` is set.
+        """
+        if generator is not None:
+            pulumi.set(__self__, "generator", generator)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def generator(self) -> Optional['outputs.Generator']:
+        """
+        How to generate the value to pin. A new value is only generated when the pair rotates.
+        """
+        return pulumi.get(self, "generator")
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[str]:
+        """
+        The value to pin. Exactly one of `value` and `generator` is set.
+        """
+        return pulumi.get(self, "value")
+
+
+@pulumi.output_type
+class ScryptOptions(dict):
+    @staticmethod
+    def __key_warning(key: str):
+        suggest = None
+        if key == "logN":
+            suggest = "log_n"
+
+        if suggest:
+            pulumi.log.warn(f"Key '{key}' not found in ScryptOptions. Access the value via the '{suggest}' property getter instead.")
+
+    def __getitem__(self, key: str) -> Any:
+        ScryptOptions.__key_warning(key)
+        return super().__getitem__(key)
+
+    def get(self, key: str, default = None) -> Any:
+        ScryptOptions.__key_warning(key)
+        return super().get(key, default)
+
+    def __init__(__self__, *,
+                 log_n: Optional[int] = None,
+                 p: Optional[int] = None,
+                 r: Optional[int] = None):
+        """
+        :param int log_n: The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+        :param int p: The parallelization. Defaults to 1.
+        :param int r: The block size. Defaults to 8.
+        """
+        if log_n is not None:
+            pulumi.set(__self__, "log_n", log_n)
+        if p is not None:
+            pulumi.set(__self__, "p", p)
+        if r is not None:
+            pulumi.set(__self__, "r", r)
+
+    @property
+    @pulumi.getter(name="logN")
+    def log_n(self) -> Optional[int]:
+        """
+        The base 2 logarithm of the CPU and memory cost N. Defaults to 15. scrypt uses 128·r·N bytes of memory, which may be at most 1 GiB.
+        """
+        return pulumi.get(self, "log_n")
+
+    @property
+    @pulumi.getter
+    def p(self) -> Optional[int]:
+        """
+        The parallelization. Defaults to 1.
+        """
+        return pulumi.get(self, "p")
+
+    @property
+    @pulumi.getter
+    def r(self) -> Optional[int]:
+        """
+        The block size. Defaults to 8.
+        """
+        return pulumi.get(self, "r")
+
+
+@pulumi.output_type
+class Source(dict):
+    def __init__(__self__, *,
+                 kind: 'SourceKind',
+                 command: Optional[Sequence[str]] = None,
+                 name: Optional[str] = None,
+                 path: Optional[str] = None):
+        """
+        :param 'SourceKind' kind: The kind of source.
+        :param Sequence[str] command: The program and arguments a `command` source runs.
+        :param str name: The environment variable an `env` source reads.
+        :param str path: The file a `file` source reads.
+        """
+        pulumi.set(__self__, "kind", kind)
+        if command is not None:
+            pulumi.set(__self__, "command", command)
+        if name is not None:
+            pulumi.set(__self__, "name", name)
+        if path is not None:
+            pulumi.set(__self__, "path", path)
+
+    @property
+    @pulumi.getter
+    def kind(self) -> 'SourceKind':
+        """
+        The kind of source.
+        """
+        return pulumi.get(self, "kind")
+
+    @property
+    @pulumi.getter
+    def command(self) -> Optional[Sequence[str]]:
+        """
+        The program and arguments a `command` source runs.
+        """
+        return pulumi.get(self, "command")
+
+    @property
+    @pulumi.getter
+    def name(self) -> Optional[str]:
+        """
+        The environment variable an `env` source reads.
+        """
+        return pulumi.get(self, "name")
+
+    @property
+    @pulumi.getter
+    def path(self) -> Optional[str]:
+        """
+        The file a `file` source reads.
+        """
+        return pulumi.get(self, "path")
+
+
+@pulumi.output_type
+class Transform(dict):
+    def __init__(__self__, *,
+                 kind: 'TransformKind',
+                 length: Optional[int] = None,
+                 value: Optional[str] = None):
+        """
+        :param 'TransformKind' kind: The transform to apply.
+        :param int length: The length a `truncate` transform keeps.
+        :param str value: The text a `prefix` or `suffix` transform adds.
+        """
+        pulumi.set(__self__, "kind", kind)
+        if length is None:
+            length = 63
+        if length is not None:
+            pulumi.set(__self__, "length", length)
+        if value is not None:
+            pulumi.set(__self__, "value", value)
+
+    @property
+    @pulumi.getter
+    def kind(self) -> 'TransformKind':
+        """
+        The transform to apply.
+        """
+        return pulumi.get(self, "kind")
+
+    @property
+    @pulumi.getter
+    def length(self) -> Optional[int]:
+        """
+        The length a `truncate` transform keeps.
+        """
+        return pulumi.get(self, "length")
+
+    @property
+    @pulumi.getter
+    def value(self) -> Optional[str]:
+        """
+        The text a `prefix` or `suffix` transform adds.
+        """
+        return pulumi.get(self, "value")
+
+
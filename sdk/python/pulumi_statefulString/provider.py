@@ -13,11 +13,74 @@ __all__ = ['ProviderArgs', 'Provider']
 
 @pulumi.input_type
 class ProviderArgs:
-    def __init__(__self__):
+    def __init__(__self__, *,
+                 archive_dir: Optional[pulumi.Input[str]] = None,
+                 derive_seed: Optional[pulumi.Input[str]] = None,
+                 rotation_manifest: Optional[pulumi.Input[str]] = None,
+                 shared_store_dir: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a Provider resource.
+        :param pulumi.Input[str] archive_dir: The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        :param pulumi.Input[str] derive_seed: The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        :param pulumi.Input[str] rotation_manifest: The path of the JSON file rotation groups are read from.
+        :param pulumi.Input[str] shared_store_dir: The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
         """
-        pass
+        if archive_dir is not None:
+            pulumi.set(__self__, "archive_dir", archive_dir)
+        if derive_seed is not None:
+            pulumi.set(__self__, "derive_seed", derive_seed)
+        if rotation_manifest is not None:
+            pulumi.set(__self__, "rotation_manifest", rotation_manifest)
+        if shared_store_dir is not None:
+            pulumi.set(__self__, "shared_store_dir", shared_store_dir)
+
+    @property
+    @pulumi.getter(name="archiveDir")
+    def archive_dir(self) -> Optional[pulumi.Input[str]]:
+        """
+        The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        """
+        return pulumi.get(self, "archive_dir")
+
+    @archive_dir.setter
+    def archive_dir(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "archive_dir", value)
+
+    @property
+    @pulumi.getter(name="deriveSeed")
+    def derive_seed(self) -> Optional[pulumi.Input[str]]:
+        """
+        The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        """
+        return pulumi.get(self, "derive_seed")
+
+    @derive_seed.setter
+    def derive_seed(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "derive_seed", value)
+
+    @property
+    @pulumi.getter(name="rotationManifest")
+    def rotation_manifest(self) -> Optional[pulumi.Input[str]]:
+        """
+        The path of the JSON file rotation groups are read from.
+        """
+        return pulumi.get(self, "rotation_manifest")
+
+    @rotation_manifest.setter
+    def rotation_manifest(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "rotation_manifest", value)
+
+    @property
+    @pulumi.getter(name="sharedStoreDir")
+    def shared_store_dir(self) -> Optional[pulumi.Input[str]]:
+        """
+        The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+        """
+        return pulumi.get(self, "shared_store_dir")
+
+    @shared_store_dir.setter
+    def shared_store_dir(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "shared_store_dir", value)
 
 
 class Provider(pulumi.ProviderResource):
@@ -25,11 +88,19 @@ class Provider(pulumi.ProviderResource):
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 archive_dir: Optional[pulumi.Input[str]] = None,
+                 derive_seed: Optional[pulumi.Input[str]] = None,
+                 rotation_manifest: Optional[pulumi.Input[str]] = None,
+                 shared_store_dir: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
         Create a StatefulString resource with the given unique name, props, and options.
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] archive_dir: The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        :param pulumi.Input[str] derive_seed: The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        :param pulumi.Input[str] rotation_manifest: The path of the JSON file rotation groups are read from.
+        :param pulumi.Input[str] shared_store_dir: The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
         """
         ...
     @overload
@@ -54,6 +125,10 @@ class Provider(pulumi.ProviderResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 archive_dir: Optional[pulumi.Input[str]] = None,
+                 derive_seed: Optional[pulumi.Input[str]] = None,
+                 rotation_manifest: Optional[pulumi.Input[str]] = None,
+                 shared_store_dir: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -63,9 +138,47 @@ class Provider(pulumi.ProviderResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = ProviderArgs.__new__(ProviderArgs)
 
+            __props__.__dict__["archive_dir"] = archive_dir
+            __props__.__dict__["derive_seed"] = None if derive_seed is None else pulumi.Output.secret(derive_seed)
+            __props__.__dict__["rotation_manifest"] = rotation_manifest
+            __props__.__dict__["shared_store_dir"] = shared_store_dir
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["deriveSeed"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(Provider, __self__).__init__(
             'statefulString',
             resource_name,
             __props__,
             opts)
 
+    @property
+    @pulumi.getter(name="archiveDir")
+    def archive_dir(self) -> pulumi.Output[Optional[str]]:
+        """
+        The directory tombstones of deleted strings are kept in, in a directory per project and stack. Defaults to `~/.pulumi-statefulstring/archive`.
+        """
+        return pulumi.get(self, "archive_dir")
+
+    @property
+    @pulumi.getter(name="deriveSeed")
+    def derive_seed(self) -> pulumi.Output[Optional[str]]:
+        """
+        The secret that keys the `derive` generator. The same seed and triggers always derive the same value.
+        """
+        return pulumi.get(self, "derive_seed")
+
+    @property
+    @pulumi.getter(name="rotationManifest")
+    def rotation_manifest(self) -> pulumi.Output[Optional[str]]:
+        """
+        The path of the JSON file rotation groups are read from.
+        """
+        return pulumi.get(self, "rotation_manifest")
+
+    @property
+    @pulumi.getter(name="sharedStoreDir")
+    def shared_store_dir(self) -> pulumi.Output[Optional[str]]:
+        """
+        The directory shared strings are kept in. Defaults to `~/.pulumi-statefulstring/shared`.
+        """
+        return pulumi.get(self, "shared_store_dir")
+
@@ -0,0 +1,250 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._enums import *
+from ._inputs import *
+
+__all__ = ['SecretBundleArgs', 'SecretBundle']
+
+@pulumi.input_type
+class SecretBundleArgs:
+    def __init__(__self__, *,
+                 expires_after: Optional[pulumi.Input[str]] = None,
+                 generator: Optional[pulumi.Input['GeneratorArgs']] = None,
+                 hashes: Optional[pulumi.Input['HashOptionsArgs']] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 variants: Optional[pulumi.Input[Mapping[str, pulumi.Input['BundleVariantArgs']]]] = None):
+        """
+        The set of arguments for constructing a SecretBundle resource.
+        :param pulumi.Input[str] expires_after: How long a pinned value lives, such as `720h`.
+        :param pulumi.Input['GeneratorArgs'] generator: How to generate the pinned value. Defaults to 32 random letters and digits.
+        :param pulumi.Input['HashOptionsArgs'] hashes: The salted hashes of the pinned value to compute.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate the value, and everything derived from it, whenever any of them changes.
+        :param pulumi.Input[Mapping[str, pulumi.Input['BundleVariantArgs']]] variants: Other forms of the pinned value to derive, by name. A variant only changes when the pinned value does.
+        """
+        if expires_after is not None:
+            pulumi.set(__self__, "expires_after", expires_after)
+        if generator is not None:
+            pulumi.set(__self__, "generator", generator)
+        if hashes is not None:
+            pulumi.set(__self__, "hashes", hashes)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+        if variants is not None:
+            pulumi.set(__self__, "variants", variants)
+
+    @property
+    @pulumi.getter(name="expiresAfter")
+    def expires_after(self) -> Optional[pulumi.Input[str]]:
+        """
+        How long a pinned value lives, such as `720h`.
+        """
+        return pulumi.get(self, "expires_after")
+
+    @expires_after.setter
+    def expires_after(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "expires_after", value)
+
+    @property
+    @pulumi.getter
+    def generator(self) -> Optional[pulumi.Input['GeneratorArgs']]:
+        """
+        How to generate the pinned value. Defaults to 32 random letters and digits.
+        """
+        return pulumi.get(self, "generator")
+
+    @generator.setter
+    def generator(self, value: Optional[pulumi.Input['GeneratorArgs']]):
+        pulumi.set(self, "generator", value)
+
+    @property
+    @pulumi.getter
+    def hashes(self) -> Optional[pulumi.Input['HashOptionsArgs']]:
+        """
+        The salted hashes of the pinned value to compute.
+        """
+        return pulumi.get(self, "hashes")
+
+    @hashes.setter
+    def hashes(self, value: Optional[pulumi.Input['HashOptionsArgs']]):
+        pulumi.set(self, "hashes", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Arbitrary values that rotate the value, and everything derived from it, whenever any of them changes.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "triggers", value)
+
+    @property
+    @pulumi.getter
+    def variants(self) -> Optional[pulumi.Input[Mapping[str, pulumi.Input['BundleVariantArgs']]]]:
+        """
+        Other forms of the pinned value to derive, by name. A variant only changes when the pinned value does.
+        """
+        return pulumi.get(self, "variants")
+
+    @variants.setter
+    def variants(self, value: Optional[pulumi.Input[Mapping[str, pulumi.Input['BundleVariantArgs']]]]):
+        pulumi.set(self, "variants", value)
+
+
+class SecretBundle(pulumi.ComponentResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 expires_after: Optional[pulumi.Input[str]] = None,
+                 generator: Optional[pulumi.Input[pulumi.InputType['GeneratorArgs']]] = None,
+                 hashes: Optional[pulumi.Input[pulumi.InputType['HashOptionsArgs']]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 variants: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['BundleVariantArgs']]]]] = None,
+                 __props__=None):
+        """
+        A generated value pinned by a StatefulString, together with the forms programs derive from it. Every output rotates with the pinned value.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] expires_after: How long a pinned value lives, such as `720h`.
+        :param pulumi.Input[pulumi.InputType['GeneratorArgs']] generator: How to generate the pinned value. Defaults to 32 random letters and digits.
+        :param pulumi.Input[pulumi.InputType['HashOptionsArgs']] hashes: The salted hashes of the pinned value to compute.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate the value, and everything derived from it, whenever any of them changes.
+        :param pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['BundleVariantArgs']]]] variants: Other forms of the pinned value to derive, by name. A variant only changes when the pinned value does.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: Optional[SecretBundleArgs] = None,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A generated value pinned by a StatefulString, together with the forms programs derive from it. Every output rotates with the pinned value.
+
+        :param str resource_name: The name of the resource.
+        :param SecretBundleArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(SecretBundleArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 expires_after: Optional[pulumi.Input[str]] = None,
+                 generator: Optional[pulumi.Input[pulumi.InputType['GeneratorArgs']]] = None,
+                 hashes: Optional[pulumi.Input[pulumi.InputType['HashOptionsArgs']]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 variants: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['BundleVariantArgs']]]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is not None:
+            raise ValueError('ComponentResource classes do not support opts.id')
+        else:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = SecretBundleArgs.__new__(SecretBundleArgs)
+
+            __props__.__dict__["expires_after"] = expires_after
+            __props__.__dict__["generator"] = generator
+            __props__.__dict__["hashes"] = hashes
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["variants"] = variants
+            __props__.__dict__["argon2_hash"] = None
+            __props__.__dict__["bcrypt_hash"] = None
+            __props__.__dict__["expires_at"] = None
+            __props__.__dict__["revision"] = None
+            __props__.__dict__["scrypt_hash"] = None
+            __props__.__dict__["sha256"] = None
+            __props__.__dict__["value"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["argon2Hash", "bcryptHash", "scryptHash", "sha256", "value", "variants"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(SecretBundle, __self__).__init__(
+            'statefulString:index:SecretBundle',
+            resource_name,
+            __props__,
+            opts,
+            remote=True)
+
+    @property
+    @pulumi.getter(name="argon2Hash")
+    def argon2_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        The argon2id hash of the pinned value, when `hashes.argon2` is set.
+        """
+        return pulumi.get(self, "argon2_hash")
+
+    @property
+    @pulumi.getter(name="bcryptHash")
+    def bcrypt_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        The bcrypt hash of the pinned value, when `hashes.bcrypt` is set.
+        """
+        return pulumi.get(self, "bcrypt_hash")
+
+    @property
+    @pulumi.getter(name="expiresAt")
+    def expires_at(self) -> pulumi.Output[Optional[str]]:
+        """
+        The RFC 3339 time at which the pinned value expires.
+        """
+        return pulumi.get(self, "expires_at")
+
+    @property
+    @pulumi.getter
+    def revision(self) -> pulumi.Output[int]:
+        """
+        How many values have been pinned, starting from 1.
+        """
+        return pulumi.get(self, "revision")
+
+    @property
+    @pulumi.getter(name="scryptHash")
+    def scrypt_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        The scrypt hash of the pinned value, when `hashes.scrypt` is set.
+        """
+        return pulumi.get(self, "scrypt_hash")
+
+    @property
+    @pulumi.getter
+    def sha256(self) -> pulumi.Output[str]:
+        """
+        The hex SHA-256 digest of the pinned value.
+        """
+        return pulumi.get(self, "sha256")
+
+    @property
+    @pulumi.getter
+    def value(self) -> pulumi.Output[str]:
+        """
+        The pinned value.
+        """
+        return pulumi.get(self, "value")
+
+    @property
+    @pulumi.getter
+    def variants(self) -> pulumi.Output[Mapping[str, str]]:
+        """
+        The variants of the pinned value, by name.
+        """
+        return pulumi.get(self, "variants")
+
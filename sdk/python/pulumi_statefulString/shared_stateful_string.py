@@ -0,0 +1,189 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+
+__all__ = ['SharedStatefulStringArgs', 'SharedStatefulString']
+
+@pulumi.input_type
+class SharedStatefulStringArgs:
+    def __init__(__self__, *,
+                 key: pulumi.Input[str],
+                 string: pulumi.Input[str],
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None):
+        """
+        The set of arguments for constructing a SharedStatefulString resource.
+        :param pulumi.Input[str] key: The name of the record in the shared store. Changing it replaces the resource.
+        :param pulumi.Input[str] string: The string to pin. Changes are only picked up when a trigger changes.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+        """
+        pulumi.set(__self__, "key", key)
+        pulumi.set(__self__, "string", string)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @property
+    @pulumi.getter
+    def key(self) -> pulumi.Input[str]:
+        """
+        The name of the record in the shared store. Changing it replaces the resource.
+        """
+        return pulumi.get(self, "key")
+
+    @key.setter
+    def key(self, value: pulumi.Input[str]):
+        pulumi.set(self, "key", value)
+
+    @property
+    @pulumi.getter
+    def string(self) -> pulumi.Input[str]:
+        """
+        The string to pin. Changes are only picked up when a trigger changes.
+        """
+        return pulumi.get(self, "string")
+
+    @string.setter
+    def string(self, value: pulumi.Input[str]):
+        pulumi.set(self, "string", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "triggers", value)
+
+
+class SharedStatefulString(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 key: Optional[pulumi.Input[str]] = None,
+                 string: Optional[pulumi.Input[str]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        """
+        A string that stays pinned until one of its triggers changes, published with its triggers to the provider's shared store so that other stacks can read it with `getSharedStatefulString`.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] key: The name of the record in the shared store. Changing it replaces the resource.
+        :param pulumi.Input[str] string: The string to pin. Changes are only picked up when a trigger changes.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: SharedStatefulStringArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A string that stays pinned until one of its triggers changes, published with its triggers to the provider's shared store so that other stacks can read it with `getSharedStatefulString`.
+
+        :param str resource_name: The name of the resource.
+        :param SharedStatefulStringArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(SharedStatefulStringArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 key: Optional[pulumi.Input[str]] = None,
+                 string: Optional[pulumi.Input[str]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = SharedStatefulStringArgs.__new__(SharedStatefulStringArgs)
+
+            if key is None and not opts.urn:
+                raise TypeError("Missing required property 'key'")
+            __props__.__dict__["key"] = key
+            if string is None and not opts.urn:
+                raise TypeError("Missing required property 'string'")
+            __props__.__dict__["string"] = string
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["owner_id"] = None
+        super(SharedStatefulString, __self__).__init__(
+            'statefulString:index:SharedStatefulString',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'SharedStatefulString':
+        """
+        Get an existing SharedStatefulString resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = SharedStatefulStringArgs.__new__(SharedStatefulStringArgs)
+
+        __props__.__dict__["key"] = None
+        __props__.__dict__["owner_id"] = None
+        __props__.__dict__["string"] = None
+        __props__.__dict__["triggers"] = None
+        return SharedStatefulString(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def key(self) -> pulumi.Output[str]:
+        """
+        The name of the record in the shared store. Changing it replaces the resource.
+        """
+        return pulumi.get(self, "key")
+
+    @property
+    @pulumi.getter(name="ownerId")
+    def owner_id(self) -> pulumi.Output[str]:
+        """
+        Identifies this resource as the owner of the record in the shared store.
+        """
+        return pulumi.get(self, "owner_id")
+
+    @property
+    @pulumi.getter
+    def string(self) -> pulumi.Output[str]:
+        """
+        The string to pin. Changes are only picked up when a trigger changes.
+        """
+        return pulumi.get(self, "string")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Arbitrary values that rotate the string whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
@@ -0,0 +1,494 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._enums import *
+from ._inputs import *
+
+__all__ = ['StatefulCertificateArgs', 'StatefulCertificate']
+
+@pulumi.input_type
+class StatefulCertificateArgs:
+    def __init__(__self__, *,
+                 subject: pulumi.Input['CertificateSubjectArgs'],
+                 ca_cert_pem: Optional[pulumi.Input[str]] = None,
+                 ca_private_key_pem: Optional[pulumi.Input[str]] = None,
+                 dns_names: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ecdsa_curve: Optional[pulumi.Input['ECDSACurve']] = None,
+                 ip_addresses: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 is_ca: Optional[pulumi.Input[bool]] = None,
+                 key_algorithm: Optional[pulumi.Input['KeyAlgorithm']] = None,
+                 renew_before: Optional[pulumi.Input[str]] = None,
+                 rsa_bits: Optional[pulumi.Input[int]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 valid_for: Optional[pulumi.Input[str]] = None):
+        """
+        The set of arguments for constructing a StatefulCertificate resource.
+        :param pulumi.Input['CertificateSubjectArgs'] subject: The subject of the certificate.
+        :param pulumi.Input[str] ca_cert_pem: The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+        :param pulumi.Input[str] ca_private_key_pem: The PEM private key of the CA that signs the certificate.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] dns_names: The DNS names the certificate is valid for.
+        :param pulumi.Input['ECDSACurve'] ecdsa_curve: The curve of an ECDSA key. Defaults to `P256`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] ip_addresses: The IP addresses the certificate is valid for.
+        :param pulumi.Input[bool] is_ca: Whether the certificate can sign other certificates, for use as a local CA.
+        :param pulumi.Input['KeyAlgorithm'] key_algorithm: The kind of key to generate. Defaults to `ecdsa`.
+        :param pulumi.Input[str] renew_before: How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+        :param pulumi.Input[int] rsa_bits: The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+        :param pulumi.Input[str] valid_for: How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+        """
+        pulumi.set(__self__, "subject", subject)
+        if ca_cert_pem is not None:
+            pulumi.set(__self__, "ca_cert_pem", ca_cert_pem)
+        if ca_private_key_pem is not None:
+            pulumi.set(__self__, "ca_private_key_pem", ca_private_key_pem)
+        if dns_names is not None:
+            pulumi.set(__self__, "dns_names", dns_names)
+        if ecdsa_curve is not None:
+            pulumi.set(__self__, "ecdsa_curve", ecdsa_curve)
+        if ip_addresses is not None:
+            pulumi.set(__self__, "ip_addresses", ip_addresses)
+        if is_ca is not None:
+            pulumi.set(__self__, "is_ca", is_ca)
+        if key_algorithm is not None:
+            pulumi.set(__self__, "key_algorithm", key_algorithm)
+        if renew_before is not None:
+            pulumi.set(__self__, "renew_before", renew_before)
+        if rsa_bits is not None:
+            pulumi.set(__self__, "rsa_bits", rsa_bits)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+        if valid_for is not None:
+            pulumi.set(__self__, "valid_for", valid_for)
+
+    @property
+    @pulumi.getter
+    def subject(self) -> pulumi.Input['CertificateSubjectArgs']:
+        """
+        The subject of the certificate.
+        """
+        return pulumi.get(self, "subject")
+
+    @subject.setter
+    def subject(self, value: pulumi.Input['CertificateSubjectArgs']):
+        pulumi.set(self, "subject", value)
+
+    @property
+    @pulumi.getter(name="caCertPem")
+    def ca_cert_pem(self) -> Optional[pulumi.Input[str]]:
+        """
+        The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+        """
+        return pulumi.get(self, "ca_cert_pem")
+
+    @ca_cert_pem.setter
+    def ca_cert_pem(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ca_cert_pem", value)
+
+    @property
+    @pulumi.getter(name="caPrivateKeyPem")
+    def ca_private_key_pem(self) -> Optional[pulumi.Input[str]]:
+        """
+        The PEM private key of the CA that signs the certificate.
+        """
+        return pulumi.get(self, "ca_private_key_pem")
+
+    @ca_private_key_pem.setter
+    def ca_private_key_pem(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "ca_private_key_pem", value)
+
+    @property
+    @pulumi.getter(name="dnsNames")
+    def dns_names(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The DNS names the certificate is valid for.
+        """
+        return pulumi.get(self, "dns_names")
+
+    @dns_names.setter
+    def dns_names(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "dns_names", value)
+
+    @property
+    @pulumi.getter(name="ecdsaCurve")
+    def ecdsa_curve(self) -> Optional[pulumi.Input['ECDSACurve']]:
+        """
+        The curve of an ECDSA key. Defaults to `P256`.
+        """
+        return pulumi.get(self, "ecdsa_curve")
+
+    @ecdsa_curve.setter
+    def ecdsa_curve(self, value: Optional[pulumi.Input['ECDSACurve']]):
+        pulumi.set(self, "ecdsa_curve", value)
+
+    @property
+    @pulumi.getter(name="ipAddresses")
+    def ip_addresses(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The IP addresses the certificate is valid for.
+        """
+        return pulumi.get(self, "ip_addresses")
+
+    @ip_addresses.setter
+    def ip_addresses(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "ip_addresses", value)
+
+    @property
+    @pulumi.getter(name="isCa")
+    def is_ca(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Whether the certificate can sign other certificates, for use as a local CA.
+        """
+        return pulumi.get(self, "is_ca")
+
+    @is_ca.setter
+    def is_ca(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "is_ca", value)
+
+    @property
+    @pulumi.getter(name="keyAlgorithm")
+    def key_algorithm(self) -> Optional[pulumi.Input['KeyAlgorithm']]:
+        """
+        The kind of key to generate. Defaults to `ecdsa`.
+        """
+        return pulumi.get(self, "key_algorithm")
+
+    @key_algorithm.setter
+    def key_algorithm(self, value: Optional[pulumi.Input['KeyAlgorithm']]):
+        pulumi.set(self, "key_algorithm", value)
+
+    @property
+    @pulumi.getter(name="renewBefore")
+    def renew_before(self) -> Optional[pulumi.Input[str]]:
+        """
+        How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+        """
+        return pulumi.get(self, "renew_before")
+
+    @renew_before.setter
+    def renew_before(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "renew_before", value)
+
+    @property
+    @pulumi.getter(name="rsaBits")
+    def rsa_bits(self) -> Optional[pulumi.Input[int]]:
+        """
+        The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        """
+        return pulumi.get(self, "rsa_bits")
+
+    @rsa_bits.setter
+    def rsa_bits(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "rsa_bits", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "triggers", value)
+
+    @property
+    @pulumi.getter(name="validFor")
+    def valid_for(self) -> Optional[pulumi.Input[str]]:
+        """
+        How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+        """
+        return pulumi.get(self, "valid_for")
+
+    @valid_for.setter
+    def valid_for(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "valid_for", value)
+
+
+class StatefulCertificate(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 ca_cert_pem: Optional[pulumi.Input[str]] = None,
+                 ca_private_key_pem: Optional[pulumi.Input[str]] = None,
+                 dns_names: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ecdsa_curve: Optional[pulumi.Input['ECDSACurve']] = None,
+                 ip_addresses: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 is_ca: Optional[pulumi.Input[bool]] = None,
+                 key_algorithm: Optional[pulumi.Input['KeyAlgorithm']] = None,
+                 renew_before: Optional[pulumi.Input[str]] = None,
+                 rsa_bits: Optional[pulumi.Input[int]] = None,
+                 subject: Optional[pulumi.Input[pulumi.InputType['CertificateSubjectArgs']]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 valid_for: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        """
+        A self-signed or locally-CA-signed certificate that stays pinned until its contents or triggers change, or until it is within `renewBefore` of expiring.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[str] ca_cert_pem: The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+        :param pulumi.Input[str] ca_private_key_pem: The PEM private key of the CA that signs the certificate.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] dns_names: The DNS names the certificate is valid for.
+        :param pulumi.Input['ECDSACurve'] ecdsa_curve: The curve of an ECDSA key. Defaults to `P256`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] ip_addresses: The IP addresses the certificate is valid for.
+        :param pulumi.Input[bool] is_ca: Whether the certificate can sign other certificates, for use as a local CA.
+        :param pulumi.Input['KeyAlgorithm'] key_algorithm: The kind of key to generate. Defaults to `ecdsa`.
+        :param pulumi.Input[str] renew_before: How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+        :param pulumi.Input[int] rsa_bits: The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        :param pulumi.Input[pulumi.InputType['CertificateSubjectArgs']] subject: The subject of the certificate.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+        :param pulumi.Input[str] valid_for: How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: StatefulCertificateArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A self-signed or locally-CA-signed certificate that stays pinned until its contents or triggers change, or until it is within `renewBefore` of expiring.
+
+        :param str resource_name: The name of the resource.
+        :param StatefulCertificateArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(StatefulCertificateArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 ca_cert_pem: Optional[pulumi.Input[str]] = None,
+                 ca_private_key_pem: Optional[pulumi.Input[str]] = None,
+                 dns_names: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 ecdsa_curve: Optional[pulumi.Input['ECDSACurve']] = None,
+                 ip_addresses: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 is_ca: Optional[pulumi.Input[bool]] = None,
+                 key_algorithm: Optional[pulumi.Input['KeyAlgorithm']] = None,
+                 renew_before: Optional[pulumi.Input[str]] = None,
+                 rsa_bits: Optional[pulumi.Input[int]] = None,
+                 subject: Optional[pulumi.Input[pulumi.InputType['CertificateSubjectArgs']]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 valid_for: Optional[pulumi.Input[str]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = StatefulCertificateArgs.__new__(StatefulCertificateArgs)
+
+            __props__.__dict__["ca_cert_pem"] = ca_cert_pem
+            __props__.__dict__["ca_private_key_pem"] = None if ca_private_key_pem is None else pulumi.Output.secret(ca_private_key_pem)
+            __props__.__dict__["dns_names"] = dns_names
+            __props__.__dict__["ecdsa_curve"] = ecdsa_curve
+            __props__.__dict__["ip_addresses"] = ip_addresses
+            __props__.__dict__["is_ca"] = is_ca
+            __props__.__dict__["key_algorithm"] = key_algorithm
+            __props__.__dict__["renew_before"] = renew_before
+            __props__.__dict__["rsa_bits"] = rsa_bits
+            if subject is None and not opts.urn:
+                raise TypeError("Missing required property 'subject'")
+            __props__.__dict__["subject"] = subject
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["valid_for"] = valid_for
+            __props__.__dict__["cert_pem"] = None
+            __props__.__dict__["fingerprint_sha256"] = None
+            __props__.__dict__["not_after"] = None
+            __props__.__dict__["not_before"] = None
+            __props__.__dict__["private_key_pem"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["caPrivateKeyPem", "privateKeyPem"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(StatefulCertificate, __self__).__init__(
+            'statefulString:index:StatefulCertificate',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'StatefulCertificate':
+        """
+        Get an existing StatefulCertificate resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = StatefulCertificateArgs.__new__(StatefulCertificateArgs)
+
+        __props__.__dict__["ca_cert_pem"] = None
+        __props__.__dict__["ca_private_key_pem"] = None
+        __props__.__dict__["cert_pem"] = None
+        __props__.__dict__["dns_names"] = None
+        __props__.__dict__["ecdsa_curve"] = None
+        __props__.__dict__["fingerprint_sha256"] = None
+        __props__.__dict__["ip_addresses"] = None
+        __props__.__dict__["is_ca"] = None
+        __props__.__dict__["key_algorithm"] = None
+        __props__.__dict__["not_after"] = None
+        __props__.__dict__["not_before"] = None
+        __props__.__dict__["private_key_pem"] = None
+        __props__.__dict__["renew_before"] = None
+        __props__.__dict__["rsa_bits"] = None
+        __props__.__dict__["subject"] = None
+        __props__.__dict__["triggers"] = None
+        __props__.__dict__["valid_for"] = None
+        return StatefulCertificate(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter(name="caCertPem")
+    def ca_cert_pem(self) -> pulumi.Output[Optional[str]]:
+        """
+        The PEM certificate of the CA that signs the certificate. Without it, the certificate is self-signed.
+        """
+        return pulumi.get(self, "ca_cert_pem")
+
+    @property
+    @pulumi.getter(name="caPrivateKeyPem")
+    def ca_private_key_pem(self) -> pulumi.Output[Optional[str]]:
+        """
+        The PEM private key of the CA that signs the certificate.
+        """
+        return pulumi.get(self, "ca_private_key_pem")
+
+    @property
+    @pulumi.getter(name="certPem")
+    def cert_pem(self) -> pulumi.Output[str]:
+        """
+        The certificate in PEM format.
+        """
+        return pulumi.get(self, "cert_pem")
+
+    @property
+    @pulumi.getter(name="dnsNames")
+    def dns_names(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The DNS names the certificate is valid for.
+        """
+        return pulumi.get(self, "dns_names")
+
+    @property
+    @pulumi.getter(name="ecdsaCurve")
+    def ecdsa_curve(self) -> pulumi.Output[Optional['ECDSACurve']]:
+        """
+        The curve of an ECDSA key. Defaults to `P256`.
+        """
+        return pulumi.get(self, "ecdsa_curve")
+
+    @property
+    @pulumi.getter(name="fingerprintSha256")
+    def fingerprint_sha256(self) -> pulumi.Output[str]:
+        """
+        The hex SHA-256 digest of the DER certificate.
+        """
+        return pulumi.get(self, "fingerprint_sha256")
+
+    @property
+    @pulumi.getter(name="ipAddresses")
+    def ip_addresses(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The IP addresses the certificate is valid for.
+        """
+        return pulumi.get(self, "ip_addresses")
+
+    @property
+    @pulumi.getter(name="isCa")
+    def is_ca(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the certificate can sign other certificates, for use as a local CA.
+        """
+        return pulumi.get(self, "is_ca")
+
+    @property
+    @pulumi.getter(name="keyAlgorithm")
+    def key_algorithm(self) -> pulumi.Output[Optional['KeyAlgorithm']]:
+        """
+        The kind of key to generate. Defaults to `ecdsa`.
+        """
+        return pulumi.get(self, "key_algorithm")
+
+    @property
+    @pulumi.getter(name="notAfter")
+    def not_after(self) -> pulumi.Output[str]:
+        """
+        The RFC 3339 time at which the certificate expires.
+        """
+        return pulumi.get(self, "not_after")
+
+    @property
+    @pulumi.getter(name="notBefore")
+    def not_before(self) -> pulumi.Output[str]:
+        """
+        The RFC 3339 time from which the certificate is valid.
+        """
+        return pulumi.get(self, "not_before")
+
+    @property
+    @pulumi.getter(name="privateKeyPem")
+    def private_key_pem(self) -> pulumi.Output[str]:
+        """
+        The private key of the certificate in PKCS #8 PEM format.
+        """
+        return pulumi.get(self, "private_key_pem")
+
+    @property
+    @pulumi.getter(name="renewBefore")
+    def renew_before(self) -> pulumi.Output[Optional[str]]:
+        """
+        How long before `notAfter` the certificate is renewed. Defaults to `720h`.
+        """
+        return pulumi.get(self, "renew_before")
+
+    @property
+    @pulumi.getter(name="rsaBits")
+    def rsa_bits(self) -> pulumi.Output[Optional[int]]:
+        """
+        The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        """
+        return pulumi.get(self, "rsa_bits")
+
+    @property
+    @pulumi.getter
+    def subject(self) -> pulumi.Output['outputs.CertificateSubject']:
+        """
+        The subject of the certificate.
+        """
+        return pulumi.get(self, "subject")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Arbitrary values that renew the certificate whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
+    @property
+    @pulumi.getter(name="validFor")
+    def valid_for(self) -> pulumi.Output[Optional[str]]:
+        """
+        How long the certificate is valid for, such as `720h`. Defaults to `8760h`.
+        """
+        return pulumi.get(self, "valid_for")
+
@@ -0,0 +1,260 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from ._enums import *
+
+__all__ = ['StatefulKeyPairArgs', 'StatefulKeyPair']
+
+@pulumi.input_type
+class StatefulKeyPairArgs:
+    def __init__(__self__, *,
+                 algorithm: pulumi.Input['KeyAlgorithm'],
+                 ecdsa_curve: Optional[pulumi.Input['ECDSACurve']] = None,
+                 rsa_bits: Optional[pulumi.Input[int]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None):
+        """
+        The set of arguments for constructing a StatefulKeyPair resource.
+        :param pulumi.Input['KeyAlgorithm'] algorithm: The kind of key to generate.
+        :param pulumi.Input['ECDSACurve'] ecdsa_curve: The curve of an ECDSA key. Defaults to `P256`.
+        :param pulumi.Input[int] rsa_bits: The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+        """
+        pulumi.set(__self__, "algorithm", algorithm)
+        if ecdsa_curve is not None:
+            pulumi.set(__self__, "ecdsa_curve", ecdsa_curve)
+        if rsa_bits is not None:
+            pulumi.set(__self__, "rsa_bits", rsa_bits)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @property
+    @pulumi.getter
+    def algorithm(self) -> pulumi.Input['KeyAlgorithm']:
+        """
+        The kind of key to generate.
+        """
+        return pulumi.get(self, "algorithm")
+
+    @algorithm.setter
+    def algorithm(self, value: pulumi.Input['KeyAlgorithm']):
+        pulumi.set(self, "algorithm", value)
+
+    @property
+    @pulumi.getter(name="ecdsaCurve")
+    def ecdsa_curve(self) -> Optional[pulumi.Input['ECDSACurve']]:
+        """
+        The curve of an ECDSA key. Defaults to `P256`.
+        """
+        return pulumi.get(self, "ecdsa_curve")
+
+    @ecdsa_curve.setter
+    def ecdsa_curve(self, value: Optional[pulumi.Input['ECDSACurve']]):
+        pulumi.set(self, "ecdsa_curve", value)
+
+    @property
+    @pulumi.getter(name="rsaBits")
+    def rsa_bits(self) -> Optional[pulumi.Input[int]]:
+        """
+        The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        """
+        return pulumi.get(self, "rsa_bits")
+
+    @rsa_bits.setter
+    def rsa_bits(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "rsa_bits", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "triggers", value)
+
+
+class StatefulKeyPair(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 algorithm: Optional[pulumi.Input['KeyAlgorithm']] = None,
+                 ecdsa_curve: Optional[pulumi.Input['ECDSACurve']] = None,
+                 rsa_bits: Optional[pulumi.Input[int]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        """
+        A generated key pair that stays pinned until one of its triggers changes. Changes to the key settings are only applied by the next rotation.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input['KeyAlgorithm'] algorithm: The kind of key to generate.
+        :param pulumi.Input['ECDSACurve'] ecdsa_curve: The curve of an ECDSA key. Defaults to `P256`.
+        :param pulumi.Input[int] rsa_bits: The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: StatefulKeyPairArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        A generated key pair that stays pinned until one of its triggers changes. Changes to the key settings are only applied by the next rotation.
+
+        :param str resource_name: The name of the resource.
+        :param StatefulKeyPairArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(StatefulKeyPairArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 algorithm: Optional[pulumi.Input['KeyAlgorithm']] = None,
+                 ecdsa_curve: Optional[pulumi.Input['ECDSACurve']] = None,
+                 rsa_bits: Optional[pulumi.Input[int]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = StatefulKeyPairArgs.__new__(StatefulKeyPairArgs)
+
+            if algorithm is None and not opts.urn:
+                raise TypeError("Missing required property 'algorithm'")
+            __props__.__dict__["algorithm"] = algorithm
+            __props__.__dict__["ecdsa_curve"] = ecdsa_curve
+            __props__.__dict__["rsa_bits"] = rsa_bits
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["fingerprint_sha256"] = None
+            __props__.__dict__["private_key_openssh"] = None
+            __props__.__dict__["private_key_pem"] = None
+            __props__.__dict__["public_key_openssh"] = None
+            __props__.__dict__["public_key_pem"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["privateKeyOpenssh", "privateKeyPem"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(StatefulKeyPair, __self__).__init__(
+            'statefulString:index:StatefulKeyPair',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'StatefulKeyPair':
+        """
+        Get an existing StatefulKeyPair resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = StatefulKeyPairArgs.__new__(StatefulKeyPairArgs)
+
+        __props__.__dict__["algorithm"] = None
+        __props__.__dict__["ecdsa_curve"] = None
+        __props__.__dict__["fingerprint_sha256"] = None
+        __props__.__dict__["private_key_openssh"] = None
+        __props__.__dict__["private_key_pem"] = None
+        __props__.__dict__["public_key_openssh"] = None
+        __props__.__dict__["public_key_pem"] = None
+        __props__.__dict__["rsa_bits"] = None
+        __props__.__dict__["triggers"] = None
+        return StatefulKeyPair(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def algorithm(self) -> pulumi.Output['KeyAlgorithm']:
+        """
+        The kind of key to generate.
+        """
+        return pulumi.get(self, "algorithm")
+
+    @property
+    @pulumi.getter(name="ecdsaCurve")
+    def ecdsa_curve(self) -> pulumi.Output[Optional['ECDSACurve']]:
+        """
+        The curve of an ECDSA key. Defaults to `P256`.
+        """
+        return pulumi.get(self, "ecdsa_curve")
+
+    @property
+    @pulumi.getter(name="fingerprintSha256")
+    def fingerprint_sha256(self) -> pulumi.Output[str]:
+        """
+        The OpenSSH SHA256 fingerprint of the public key.
+        """
+        return pulumi.get(self, "fingerprint_sha256")
+
+    @property
+    @pulumi.getter(name="privateKeyOpenssh")
+    def private_key_openssh(self) -> pulumi.Output[str]:
+        """
+        The private key in OpenSSH format.
+        """
+        return pulumi.get(self, "private_key_openssh")
+
+    @property
+    @pulumi.getter(name="privateKeyPem")
+    def private_key_pem(self) -> pulumi.Output[str]:
+        """
+        The private key in PKCS #8 PEM format.
+        """
+        return pulumi.get(self, "private_key_pem")
+
+    @property
+    @pulumi.getter(name="publicKeyOpenssh")
+    def public_key_openssh(self) -> pulumi.Output[str]:
+        """
+        The public key in OpenSSH authorized_keys format.
+        """
+        return pulumi.get(self, "public_key_openssh")
+
+    @property
+    @pulumi.getter(name="publicKeyPem")
+    def public_key_pem(self) -> pulumi.Output[str]:
+        """
+        The public key in PKIX PEM format.
+        """
+        return pulumi.get(self, "public_key_pem")
+
+    @property
+    @pulumi.getter(name="rsaBits")
+    def rsa_bits(self) -> pulumi.Output[Optional[int]]:
+        """
+        The size of an RSA key in bits: 2048, 3072 or 4096. Defaults to 4096.
+        """
+        return pulumi.get(self, "rsa_bits")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Arbitrary values that regenerate the key pair whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
@@ -8,60 +8,509 @@ import pulumi
 import pulumi.runtime
 from typing import Any, Mapping, Optional, Sequence, Union, overload
 from . import _utilities
+from . import outputs
+from ._enums import *
+from ._inputs import *
 
 __all__ = ['StatefulStringArgs', 'StatefulString']
 
 @pulumi.input_type
 class StatefulStringArgs:
     def __init__(__self__, *,
-                 string: pulumi.Input[str],
-                 triggers: pulumi.Input[Mapping[str, pulumi.Input[str]]]):
+                 allowed_values: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 charset: Optional[pulumi.Input[str]] = None,
+                 expires_after: Optional[pulumi.Input[str]] = None,
+                 force_rotate: Optional[pulumi.Input[str]] = None,
+                 generator: Optional[pulumi.Input['GeneratorArgs']] = None,
+                 hashes: Optional[pulumi.Input['HashOptionsArgs']] = None,
+                 json_schema: Optional[pulumi.Input[str]] = None,
+                 max_length: Optional[pulumi.Input[int]] = None,
+                 min_length: Optional[pulumi.Input[int]] = None,
+                 on_expiry: Optional[pulumi.Input['ExpiryPolicy']] = None,
+                 pattern: Optional[pulumi.Input[str]] = None,
+                 previous_retention: Optional[pulumi.Input[str]] = None,
+                 promote: Optional[pulumi.Input[str]] = None,
+                 promote_after_updates: Optional[pulumi.Input[int]] = None,
+                 restore_from: Optional[pulumi.Input[str]] = None,
+                 retain_on_delete: Optional[pulumi.Input[bool]] = None,
+                 rotate_on_drift: Optional[pulumi.Input[bool]] = None,
+                 rotation_group: Optional[pulumi.Input[str]] = None,
+                 rotation_mode: Optional[pulumi.Input['RotationMode']] = None,
+                 sensitive_triggers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 source: Optional[pulumi.Input['SourceArgs']] = None,
+                 staged: Optional[pulumi.Input[bool]] = None,
+                 string: Optional[pulumi.Input[str]] = None,
+                 transforms: Optional[pulumi.Input[Sequence[pulumi.Input['TransformArgs']]]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 warn_before: Optional[pulumi.Input[str]] = None):
         """
         The set of arguments for constructing a StatefulString resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_values: The only values the string may take before it is pinned.
+        :param pulumi.Input[str] charset: The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+        :param pulumi.Input[str] expires_after: How long a pinned string lives, such as `720h`.
+        :param pulumi.Input[str] force_rotate: A nonce: any change to it pins the string regardless of triggers.
+        :param pulumi.Input['GeneratorArgs'] generator: How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+        :param pulumi.Input['HashOptionsArgs'] hashes: The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+        :param pulumi.Input[str] json_schema: A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+        :param pulumi.Input[int] max_length: The most characters the string may have before it is pinned.
+        :param pulumi.Input[int] min_length: The fewest characters the string may have before it is pinned.
+        :param pulumi.Input['ExpiryPolicy'] on_expiry: What happens once the pinned string has expired. Defaults to `warn`.
+        :param pulumi.Input[str] pattern: A regular expression the string must match before it is pinned.
+        :param pulumi.Input[str] previous_retention: How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+        :param pulumi.Input[str] promote: A nonce: any change to it pins the pending string.
+        :param pulumi.Input[int] promote_after_updates: Pin the pending string automatically on this many updates after it was staged.
+        :param pulumi.Input[str] restore_from: The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+        :param pulumi.Input[bool] retain_on_delete: Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+        :param pulumi.Input[bool] rotate_on_drift: Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+        :param pulumi.Input[str] rotation_group: A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+        :param pulumi.Input['RotationMode'] rotation_mode: Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sensitive_triggers: Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+        :param pulumi.Input['SourceArgs'] source: Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+        :param pulumi.Input[bool] staged: Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+        :param pulumi.Input[str] string: The string to pin. Changes are only picked up when the string rotates.
+        :param pulumi.Input[Sequence[pulumi.Input['TransformArgs']]] transforms: Transforms applied in order to the pinned string to compute `result`.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+        :param pulumi.Input[str] warn_before: How long before expiry every diff starts to warn, such as `72h`.
+        """
+        if allowed_values is not None:
+            pulumi.set(__self__, "allowed_values", allowed_values)
+        if charset is not None:
+            pulumi.set(__self__, "charset", charset)
+        if expires_after is not None:
+            pulumi.set(__self__, "expires_after", expires_after)
+        if force_rotate is not None:
+            pulumi.set(__self__, "force_rotate", force_rotate)
+        if generator is not None:
+            pulumi.set(__self__, "generator", generator)
+        if hashes is not None:
+            pulumi.set(__self__, "hashes", hashes)
+        if json_schema is not None:
+            pulumi.set(__self__, "json_schema", json_schema)
+        if max_length is not None:
+            pulumi.set(__self__, "max_length", max_length)
+        if min_length is not None:
+            pulumi.set(__self__, "min_length", min_length)
+        if on_expiry is not None:
+            pulumi.set(__self__, "on_expiry", on_expiry)
+        if pattern is not None:
+            pulumi.set(__self__, "pattern", pattern)
+        if previous_retention is not None:
+            pulumi.set(__self__, "previous_retention", previous_retention)
+        if promote is not None:
+            pulumi.set(__self__, "promote", promote)
+        if promote_after_updates is not None:
+            pulumi.set(__self__, "promote_after_updates", promote_after_updates)
+        if restore_from is not None:
+            pulumi.set(__self__, "restore_from", restore_from)
+        if retain_on_delete is not None:
+            pulumi.set(__self__, "retain_on_delete", retain_on_delete)
+        if rotate_on_drift is not None:
+            pulumi.set(__self__, "rotate_on_drift", rotate_on_drift)
+        if rotation_group is not None:
+            pulumi.set(__self__, "rotation_group", rotation_group)
+        if rotation_mode is not None:
+            pulumi.set(__self__, "rotation_mode", rotation_mode)
+        if sensitive_triggers is not None:
+            pulumi.set(__self__, "sensitive_triggers", sensitive_triggers)
+        if source is not None:
+            pulumi.set(__self__, "source", source)
+        if staged is not None:
+            pulumi.set(__self__, "staged", staged)
+        if string is not None:
+            pulumi.set(__self__, "string", string)
+        if transforms is not None:
+            pulumi.set(__self__, "transforms", transforms)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+        if warn_before is not None:
+            pulumi.set(__self__, "warn_before", warn_before)
+
+    @property
+    @pulumi.getter(name="allowedValues")
+    def allowed_values(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        The only values the string may take before it is pinned.
+        """
+        return pulumi.get(self, "allowed_values")
+
+    @allowed_values.setter
+    def allowed_values(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "allowed_values", value)
+
+    @property
+    @pulumi.getter
+    def charset(self) -> Optional[pulumi.Input[str]]:
+        """
+        The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+        """
+        return pulumi.get(self, "charset")
+
+    @charset.setter
+    def charset(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "charset", value)
+
+    @property
+    @pulumi.getter(name="expiresAfter")
+    def expires_after(self) -> Optional[pulumi.Input[str]]:
+        """
+        How long a pinned string lives, such as `720h`.
+        """
+        return pulumi.get(self, "expires_after")
+
+    @expires_after.setter
+    def expires_after(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "expires_after", value)
+
+    @property
+    @pulumi.getter(name="forceRotate")
+    def force_rotate(self) -> Optional[pulumi.Input[str]]:
+        """
+        A nonce: any change to it pins the string regardless of triggers.
+        """
+        return pulumi.get(self, "force_rotate")
+
+    @force_rotate.setter
+    def force_rotate(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "force_rotate", value)
+
+    @property
+    @pulumi.getter
+    def generator(self) -> Optional[pulumi.Input['GeneratorArgs']]:
+        """
+        How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+        """
+        return pulumi.get(self, "generator")
+
+    @generator.setter
+    def generator(self, value: Optional[pulumi.Input['GeneratorArgs']]):
+        pulumi.set(self, "generator", value)
+
+    @property
+    @pulumi.getter
+    def hashes(self) -> Optional[pulumi.Input['HashOptionsArgs']]:
+        """
+        The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+        """
+        return pulumi.get(self, "hashes")
+
+    @hashes.setter
+    def hashes(self, value: Optional[pulumi.Input['HashOptionsArgs']]):
+        pulumi.set(self, "hashes", value)
+
+    @property
+    @pulumi.getter(name="jsonSchema")
+    def json_schema(self) -> Optional[pulumi.Input[str]]:
+        """
+        A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+        """
+        return pulumi.get(self, "json_schema")
+
+    @json_schema.setter
+    def json_schema(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "json_schema", value)
+
+    @property
+    @pulumi.getter(name="maxLength")
+    def max_length(self) -> Optional[pulumi.Input[int]]:
+        """
+        The most characters the string may have before it is pinned.
+        """
+        return pulumi.get(self, "max_length")
+
+    @max_length.setter
+    def max_length(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "max_length", value)
+
+    @property
+    @pulumi.getter(name="minLength")
+    def min_length(self) -> Optional[pulumi.Input[int]]:
+        """
+        The fewest characters the string may have before it is pinned.
+        """
+        return pulumi.get(self, "min_length")
+
+    @min_length.setter
+    def min_length(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "min_length", value)
+
+    @property
+    @pulumi.getter(name="onExpiry")
+    def on_expiry(self) -> Optional[pulumi.Input['ExpiryPolicy']]:
+        """
+        What happens once the pinned string has expired. Defaults to `warn`.
+        """
+        return pulumi.get(self, "on_expiry")
+
+    @on_expiry.setter
+    def on_expiry(self, value: Optional[pulumi.Input['ExpiryPolicy']]):
+        pulumi.set(self, "on_expiry", value)
+
+    @property
+    @pulumi.getter
+    def pattern(self) -> Optional[pulumi.Input[str]]:
+        """
+        A regular expression the string must match before it is pinned.
+        """
+        return pulumi.get(self, "pattern")
+
+    @pattern.setter
+    def pattern(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "pattern", value)
+
+    @property
+    @pulumi.getter(name="previousRetention")
+    def previous_retention(self) -> Optional[pulumi.Input[str]]:
         """
-        pulumi.set(__self__, "string", string)
-        pulumi.set(__self__, "triggers", triggers)
+        How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+        """
+        return pulumi.get(self, "previous_retention")
+
+    @previous_retention.setter
+    def previous_retention(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "previous_retention", value)
 
     @property
     @pulumi.getter
-    def string(self) -> pulumi.Input[str]:
+    def promote(self) -> Optional[pulumi.Input[str]]:
+        """
+        A nonce: any change to it pins the pending string.
+        """
+        return pulumi.get(self, "promote")
+
+    @promote.setter
+    def promote(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "promote", value)
+
+    @property
+    @pulumi.getter(name="promoteAfterUpdates")
+    def promote_after_updates(self) -> Optional[pulumi.Input[int]]:
+        """
+        Pin the pending string automatically on this many updates after it was staged.
+        """
+        return pulumi.get(self, "promote_after_updates")
+
+    @promote_after_updates.setter
+    def promote_after_updates(self, value: Optional[pulumi.Input[int]]):
+        pulumi.set(self, "promote_after_updates", value)
+
+    @property
+    @pulumi.getter(name="restoreFrom")
+    def restore_from(self) -> Optional[pulumi.Input[str]]:
+        """
+        The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+        """
+        return pulumi.get(self, "restore_from")
+
+    @restore_from.setter
+    def restore_from(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "restore_from", value)
+
+    @property
+    @pulumi.getter(name="retainOnDelete")
+    def retain_on_delete(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+        """
+        return pulumi.get(self, "retain_on_delete")
+
+    @retain_on_delete.setter
+    def retain_on_delete(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "retain_on_delete", value)
+
+    @property
+    @pulumi.getter(name="rotateOnDrift")
+    def rotate_on_drift(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+        """
+        return pulumi.get(self, "rotate_on_drift")
+
+    @rotate_on_drift.setter
+    def rotate_on_drift(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "rotate_on_drift", value)
+
+    @property
+    @pulumi.getter(name="rotationGroup")
+    def rotation_group(self) -> Optional[pulumi.Input[str]]:
+        """
+        A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+        """
+        return pulumi.get(self, "rotation_group")
+
+    @rotation_group.setter
+    def rotation_group(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "rotation_group", value)
+
+    @property
+    @pulumi.getter(name="rotationMode")
+    def rotation_mode(self) -> Optional[pulumi.Input['RotationMode']]:
+        """
+        Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+        """
+        return pulumi.get(self, "rotation_mode")
+
+    @rotation_mode.setter
+    def rotation_mode(self, value: Optional[pulumi.Input['RotationMode']]):
+        pulumi.set(self, "rotation_mode", value)
+
+    @property
+    @pulumi.getter(name="sensitiveTriggers")
+    def sensitive_triggers(self) -> Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]:
+        """
+        Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+        """
+        return pulumi.get(self, "sensitive_triggers")
+
+    @sensitive_triggers.setter
+    def sensitive_triggers(self, value: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]]):
+        pulumi.set(self, "sensitive_triggers", value)
+
+    @property
+    @pulumi.getter
+    def source(self) -> Optional[pulumi.Input['SourceArgs']]:
+        """
+        Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+        """
+        return pulumi.get(self, "source")
+
+    @source.setter
+    def source(self, value: Optional[pulumi.Input['SourceArgs']]):
+        pulumi.set(self, "source", value)
+
+    @property
+    @pulumi.getter
+    def staged(self) -> Optional[pulumi.Input[bool]]:
+        """
+        Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+        """
+        return pulumi.get(self, "staged")
+
+    @staged.setter
+    def staged(self, value: Optional[pulumi.Input[bool]]):
+        pulumi.set(self, "staged", value)
+
+    @property
+    @pulumi.getter
+    def string(self) -> Optional[pulumi.Input[str]]:
+        """
+        The string to pin. Changes are only picked up when the string rotates.
+        """
         return pulumi.get(self, "string")
 
     @string.setter
-    def string(self, value: pulumi.Input[str]):
+    def string(self, value: Optional[pulumi.Input[str]]):
         pulumi.set(self, "string", value)
 
     @property
     @pulumi.getter
-    def triggers(self) -> pulumi.Input[Mapping[str, pulumi.Input[str]]]:
+    def transforms(self) -> Optional[pulumi.Input[Sequence[pulumi.Input['TransformArgs']]]]:
+        """
+        Transforms applied in order to the pinned string to compute `result`.
+        """
+        return pulumi.get(self, "transforms")
+
+    @transforms.setter
+    def transforms(self, value: Optional[pulumi.Input[Sequence[pulumi.Input['TransformArgs']]]]):
+        pulumi.set(self, "transforms", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+        """
         return pulumi.get(self, "triggers")
 
     @triggers.setter
-    def triggers(self, value: pulumi.Input[Mapping[str, pulumi.Input[str]]]):
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
         pulumi.set(self, "triggers", value)
 
+    @property
+    @pulumi.getter(name="warnBefore")
+    def warn_before(self) -> Optional[pulumi.Input[str]]:
+        """
+        How long before expiry every diff starts to warn, such as `72h`.
+        """
+        return pulumi.get(self, "warn_before")
+
+    @warn_before.setter
+    def warn_before(self, value: Optional[pulumi.Input[str]]):
+        pulumi.set(self, "warn_before", value)
+
 
 class StatefulString(pulumi.CustomResource):
     @overload
     def __init__(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_values: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 charset: Optional[pulumi.Input[str]] = None,
+                 expires_after: Optional[pulumi.Input[str]] = None,
+                 force_rotate: Optional[pulumi.Input[str]] = None,
+                 generator: Optional[pulumi.Input[pulumi.InputType['GeneratorArgs']]] = None,
+                 hashes: Optional[pulumi.Input[pulumi.InputType['HashOptionsArgs']]] = None,
+                 json_schema: Optional[pulumi.Input[str]] = None,
+                 max_length: Optional[pulumi.Input[int]] = None,
+                 min_length: Optional[pulumi.Input[int]] = None,
+                 on_expiry: Optional[pulumi.Input['ExpiryPolicy']] = None,
+                 pattern: Optional[pulumi.Input[str]] = None,
+                 previous_retention: Optional[pulumi.Input[str]] = None,
+                 promote: Optional[pulumi.Input[str]] = None,
+                 promote_after_updates: Optional[pulumi.Input[int]] = None,
+                 restore_from: Optional[pulumi.Input[str]] = None,
+                 retain_on_delete: Optional[pulumi.Input[bool]] = None,
+                 rotate_on_drift: Optional[pulumi.Input[bool]] = None,
+                 rotation_group: Optional[pulumi.Input[str]] = None,
+                 rotation_mode: Optional[pulumi.Input['RotationMode']] = None,
+                 sensitive_triggers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 source: Optional[pulumi.Input[pulumi.InputType['SourceArgs']]] = None,
+                 staged: Optional[pulumi.Input[bool]] = None,
                  string: Optional[pulumi.Input[str]] = None,
-                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 transforms: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TransformArgs']]]]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 warn_before: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         """
-        Create a StatefulString resource with the given unique name, props, and options.
+        A string that stays pinned to its first value until one of its triggers changes, at which point the latest `string` input is pinned instead.
+
         :param str resource_name: The name of the resource.
         :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] allowed_values: The only values the string may take before it is pinned.
+        :param pulumi.Input[str] charset: The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+        :param pulumi.Input[str] expires_after: How long a pinned string lives, such as `720h`.
+        :param pulumi.Input[str] force_rotate: A nonce: any change to it pins the string regardless of triggers.
+        :param pulumi.Input[pulumi.InputType['GeneratorArgs']] generator: How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+        :param pulumi.Input[pulumi.InputType['HashOptionsArgs']] hashes: The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+        :param pulumi.Input[str] json_schema: A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+        :param pulumi.Input[int] max_length: The most characters the string may have before it is pinned.
+        :param pulumi.Input[int] min_length: The fewest characters the string may have before it is pinned.
+        :param pulumi.Input['ExpiryPolicy'] on_expiry: What happens once the pinned string has expired. Defaults to `warn`.
+        :param pulumi.Input[str] pattern: A regular expression the string must match before it is pinned.
+        :param pulumi.Input[str] previous_retention: How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+        :param pulumi.Input[str] promote: A nonce: any change to it pins the pending string.
+        :param pulumi.Input[int] promote_after_updates: Pin the pending string automatically on this many updates after it was staged.
+        :param pulumi.Input[str] restore_from: The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+        :param pulumi.Input[bool] retain_on_delete: Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+        :param pulumi.Input[bool] rotate_on_drift: Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+        :param pulumi.Input[str] rotation_group: A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+        :param pulumi.Input['RotationMode'] rotation_mode: Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+        :param pulumi.Input[Sequence[pulumi.Input[str]]] sensitive_triggers: Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+        :param pulumi.Input[pulumi.InputType['SourceArgs']] source: Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+        :param pulumi.Input[bool] staged: Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+        :param pulumi.Input[str] string: The string to pin. Changes are only picked up when the string rotates.
+        :param pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TransformArgs']]]] transforms: Transforms applied in order to the pinned string to compute `result`.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+        :param pulumi.Input[str] warn_before: How long before expiry every diff starts to warn, such as `72h`.
         """
         ...
     @overload
     def __init__(__self__,
                  resource_name: str,
-                 args: StatefulStringArgs,
+                 args: Optional[StatefulStringArgs] = None,
                  opts: Optional[pulumi.ResourceOptions] = None):
         """
-        Create a StatefulString resource with the given unique name, props, and options.
+        A string that stays pinned to its first value until one of its triggers changes, at which point the latest `string` input is pinned instead.
+
         :param str resource_name: The name of the resource.
         :param StatefulStringArgs args: The arguments to use to populate this resource's properties.
         :param pulumi.ResourceOptions opts: Options for the resource.
@@ -77,8 +526,32 @@ class StatefulString(pulumi.CustomResource):
     def _internal_init(__self__,
                  resource_name: str,
                  opts: Optional[pulumi.ResourceOptions] = None,
+                 allowed_values: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 charset: Optional[pulumi.Input[str]] = None,
+                 expires_after: Optional[pulumi.Input[str]] = None,
+                 force_rotate: Optional[pulumi.Input[str]] = None,
+                 generator: Optional[pulumi.Input[pulumi.InputType['GeneratorArgs']]] = None,
+                 hashes: Optional[pulumi.Input[pulumi.InputType['HashOptionsArgs']]] = None,
+                 json_schema: Optional[pulumi.Input[str]] = None,
+                 max_length: Optional[pulumi.Input[int]] = None,
+                 min_length: Optional[pulumi.Input[int]] = None,
+                 on_expiry: Optional[pulumi.Input['ExpiryPolicy']] = None,
+                 pattern: Optional[pulumi.Input[str]] = None,
+                 previous_retention: Optional[pulumi.Input[str]] = None,
+                 promote: Optional[pulumi.Input[str]] = None,
+                 promote_after_updates: Optional[pulumi.Input[int]] = None,
+                 restore_from: Optional[pulumi.Input[str]] = None,
+                 retain_on_delete: Optional[pulumi.Input[bool]] = None,
+                 rotate_on_drift: Optional[pulumi.Input[bool]] = None,
+                 rotation_group: Optional[pulumi.Input[str]] = None,
+                 rotation_mode: Optional[pulumi.Input['RotationMode']] = None,
+                 sensitive_triggers: Optional[pulumi.Input[Sequence[pulumi.Input[str]]]] = None,
+                 source: Optional[pulumi.Input[pulumi.InputType['SourceArgs']]] = None,
+                 staged: Optional[pulumi.Input[bool]] = None,
                  string: Optional[pulumi.Input[str]] = None,
-                 triggers: Optional[pulumi.Input[Mapping[str, pulumi.Input[str]]]] = None,
+                 transforms: Optional[pulumi.Input[Sequence[pulumi.Input[pulumi.InputType['TransformArgs']]]]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 warn_before: Optional[pulumi.Input[str]] = None,
                  __props__=None):
         opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
         if not isinstance(opts, pulumi.ResourceOptions):
@@ -88,12 +561,51 @@ class StatefulString(pulumi.CustomResource):
                 raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
             __props__ = StatefulStringArgs.__new__(StatefulStringArgs)
 
-            if string is None and not opts.urn:
-                raise TypeError("Missing required property 'string'")
+            __props__.__dict__["allowed_values"] = allowed_values
+            __props__.__dict__["charset"] = charset
+            __props__.__dict__["expires_after"] = expires_after
+            __props__.__dict__["force_rotate"] = force_rotate
+            __props__.__dict__["generator"] = generator
+            __props__.__dict__["hashes"] = hashes
+            __props__.__dict__["json_schema"] = json_schema
+            __props__.__dict__["max_length"] = max_length
+            __props__.__dict__["min_length"] = min_length
+            __props__.__dict__["on_expiry"] = on_expiry
+            __props__.__dict__["pattern"] = pattern
+            __props__.__dict__["previous_retention"] = previous_retention
+            __props__.__dict__["promote"] = promote
+            __props__.__dict__["promote_after_updates"] = promote_after_updates
+            __props__.__dict__["restore_from"] = restore_from
+            __props__.__dict__["retain_on_delete"] = retain_on_delete
+            __props__.__dict__["rotate_on_drift"] = rotate_on_drift
+            __props__.__dict__["rotation_group"] = rotation_group
+            __props__.__dict__["rotation_mode"] = rotation_mode
+            __props__.__dict__["sensitive_triggers"] = sensitive_triggers
+            __props__.__dict__["source"] = source
+            __props__.__dict__["staged"] = staged
             __props__.__dict__["string"] = string
-            if triggers is None and not opts.urn:
-                raise TypeError("Missing required property 'triggers'")
+            __props__.__dict__["transforms"] = transforms
             __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["warn_before"] = warn_before
+            __props__.__dict__["argon2_hash"] = None
+            __props__.__dict__["bcrypt_hash"] = None
+            __props__.__dict__["current"] = None
+            __props__.__dict__["current_source_value"] = None
+            __props__.__dict__["desired_string"] = None
+            __props__.__dict__["expires_at"] = None
+            __props__.__dict__["is_stale"] = None
+            __props__.__dict__["parsed"] = None
+            __props__.__dict__["pending"] = None
+            __props__.__dict__["pending_updates"] = None
+            __props__.__dict__["previous"] = None
+            __props__.__dict__["previous_retained_until"] = None
+            __props__.__dict__["result"] = None
+            __props__.__dict__["revision"] = None
+            __props__.__dict__["rotation_group_trigger"] = None
+            __props__.__dict__["scrypt_hash"] = None
+            __props__.__dict__["source_drift"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["argon2Hash", "bcryptHash", "scryptHash"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
         super(StatefulString, __self__).__init__(
             'statefulString:index:StatefulString',
             resource_name,
@@ -116,17 +628,392 @@ class StatefulString(pulumi.CustomResource):
 
         __props__ = StatefulStringArgs.__new__(StatefulStringArgs)
 
+        __props__.__dict__["allowed_values"] = None
+        __props__.__dict__["argon2_hash"] = None
+        __props__.__dict__["bcrypt_hash"] = None
+        __props__.__dict__["charset"] = None
+        __props__.__dict__["current"] = None
+        __props__.__dict__["current_source_value"] = None
+        __props__.__dict__["desired_string"] = None
+        __props__.__dict__["expires_after"] = None
+        __props__.__dict__["expires_at"] = None
+        __props__.__dict__["force_rotate"] = None
+        __props__.__dict__["generator"] = None
+        __props__.__dict__["hashes"] = None
+        __props__.__dict__["is_stale"] = None
+        __props__.__dict__["json_schema"] = None
+        __props__.__dict__["max_length"] = None
+        __props__.__dict__["min_length"] = None
+        __props__.__dict__["on_expiry"] = None
+        __props__.__dict__["parsed"] = None
+        __props__.__dict__["pattern"] = None
+        __props__.__dict__["pending"] = None
+        __props__.__dict__["pending_updates"] = None
+        __props__.__dict__["previous"] = None
+        __props__.__dict__["previous_retained_until"] = None
+        __props__.__dict__["previous_retention"] = None
+        __props__.__dict__["promote"] = None
+        __props__.__dict__["promote_after_updates"] = None
+        __props__.__dict__["restore_from"] = None
+        __props__.__dict__["result"] = None
+        __props__.__dict__["retain_on_delete"] = None
+        __props__.__dict__["revision"] = None
+        __props__.__dict__["rotate_on_drift"] = None
+        __props__.__dict__["rotation_group"] = None
+        __props__.__dict__["rotation_group_trigger"] = None
+        __props__.__dict__["rotation_mode"] = None
+        __props__.__dict__["scrypt_hash"] = None
+        __props__.__dict__["sensitive_triggers"] = None
+        __props__.__dict__["source"] = None
+        __props__.__dict__["source_drift"] = None
+        __props__.__dict__["staged"] = None
         __props__.__dict__["string"] = None
+        __props__.__dict__["transforms"] = None
         __props__.__dict__["triggers"] = None
+        __props__.__dict__["warn_before"] = None
         return StatefulString(resource_name, opts=opts, __props__=__props__)
 
+    @property
+    @pulumi.getter(name="allowedValues")
+    def allowed_values(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        The only values the string may take before it is pinned.
+        """
+        return pulumi.get(self, "allowed_values")
+
+    @property
+    @pulumi.getter(name="argon2Hash")
+    def argon2_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        The argon2id hash of the pinned string in PHC format, when `hashes.argon2` is set.
+        """
+        return pulumi.get(self, "argon2_hash")
+
+    @property
+    @pulumi.getter(name="bcryptHash")
+    def bcrypt_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        The bcrypt hash of the pinned string, when `hashes.bcrypt` is set.
+        """
+        return pulumi.get(self, "bcrypt_hash")
+
     @property
     @pulumi.getter
-    def string(self) -> pulumi.Output[str]:
+    def charset(self) -> pulumi.Output[Optional[str]]:
+        """
+        The characters the string may contain, written as the body of a regular expression character class such as `a-z0-9-`.
+        """
+        return pulumi.get(self, "charset")
+
+    @property
+    @pulumi.getter
+    def current(self) -> pulumi.Output[Optional[str]]:
+        """
+        The pinned string.
+        """
+        return pulumi.get(self, "current")
+
+    @property
+    @pulumi.getter(name="currentSourceValue")
+    def current_source_value(self) -> pulumi.Output[Optional[str]]:
+        """
+        The value of the source as of the last refresh or rotation.
+        """
+        return pulumi.get(self, "current_source_value")
+
+    @property
+    @pulumi.getter(name="desiredString")
+    def desired_string(self) -> pulumi.Output[Optional[str]]:
+        """
+        The latest `string` input, even when it has not been pinned.
+        """
+        return pulumi.get(self, "desired_string")
+
+    @property
+    @pulumi.getter(name="expiresAfter")
+    def expires_after(self) -> pulumi.Output[Optional[str]]:
+        """
+        How long a pinned string lives, such as `720h`.
+        """
+        return pulumi.get(self, "expires_after")
+
+    @property
+    @pulumi.getter(name="expiresAt")
+    def expires_at(self) -> pulumi.Output[Optional[str]]:
+        """
+        The RFC 3339 time at which the pinned string expires.
+        """
+        return pulumi.get(self, "expires_at")
+
+    @property
+    @pulumi.getter(name="forceRotate")
+    def force_rotate(self) -> pulumi.Output[Optional[str]]:
+        """
+        A nonce: any change to it pins the string regardless of triggers.
+        """
+        return pulumi.get(self, "force_rotate")
+
+    @property
+    @pulumi.getter
+    def generator(self) -> pulumi.Output[Optional['outputs.Generator']]:
+        """
+        How to generate the string to pin, in place of `string`. A new string is only generated when the string rotates. A `derive` generator computes the same string from the same triggers, so rotating without changing a trigger keeps it.
+        """
+        return pulumi.get(self, "generator")
+
+    @property
+    @pulumi.getter
+    def hashes(self) -> pulumi.Output[Optional['outputs.HashOptions']]:
+        """
+        The salted hashes of the pinned string to compute. A hash is only recomputed when the pinned string or its options change.
+        """
+        return pulumi.get(self, "hashes")
+
+    @property
+    @pulumi.getter(name="isStale")
+    def is_stale(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the latest `string` input differs from the pinned string.
+        """
+        return pulumi.get(self, "is_stale")
+
+    @property
+    @pulumi.getter(name="jsonSchema")
+    def json_schema(self) -> pulumi.Output[Optional[str]]:
+        """
+        A JSON schema the string must satisfy as a JSON document. The decoded document is exposed as `parsed`.
+        """
+        return pulumi.get(self, "json_schema")
+
+    @property
+    @pulumi.getter(name="maxLength")
+    def max_length(self) -> pulumi.Output[Optional[int]]:
+        """
+        The most characters the string may have before it is pinned.
+        """
+        return pulumi.get(self, "max_length")
+
+    @property
+    @pulumi.getter(name="minLength")
+    def min_length(self) -> pulumi.Output[Optional[int]]:
+        """
+        The fewest characters the string may have before it is pinned.
+        """
+        return pulumi.get(self, "min_length")
+
+    @property
+    @pulumi.getter(name="onExpiry")
+    def on_expiry(self) -> pulumi.Output[Optional['ExpiryPolicy']]:
+        """
+        What happens once the pinned string has expired. Defaults to `warn`.
+        """
+        return pulumi.get(self, "on_expiry")
+
+    @property
+    @pulumi.getter
+    def parsed(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        The pinned string decoded as JSON. Only set when `jsonSchema` is given.
+        """
+        return pulumi.get(self, "parsed")
+
+    @property
+    @pulumi.getter
+    def pattern(self) -> pulumi.Output[Optional[str]]:
+        """
+        A regular expression the string must match before it is pinned.
+        """
+        return pulumi.get(self, "pattern")
+
+    @property
+    @pulumi.getter
+    def pending(self) -> pulumi.Output[Optional[str]]:
+        """
+        The string staged by a rotation and waiting to be promoted.
+        """
+        return pulumi.get(self, "pending")
+
+    @property
+    @pulumi.getter(name="pendingUpdates")
+    def pending_updates(self) -> pulumi.Output[Optional[int]]:
+        """
+        How many updates have happened since `pending` was staged.
+        """
+        return pulumi.get(self, "pending_updates")
+
+    @property
+    @pulumi.getter
+    def previous(self) -> pulumi.Output[Optional[str]]:
+        """
+        The string replaced by the last rotation, until `previousRetainedUntil`.
+        """
+        return pulumi.get(self, "previous")
+
+    @property
+    @pulumi.getter(name="previousRetainedUntil")
+    def previous_retained_until(self) -> pulumi.Output[Optional[str]]:
+        """
+        The RFC 3339 time until which `previous` is kept.
+        """
+        return pulumi.get(self, "previous_retained_until")
+
+    @property
+    @pulumi.getter(name="previousRetention")
+    def previous_retention(self) -> pulumi.Output[Optional[str]]:
+        """
+        How long `previous` keeps the string replaced by a rotation, such as `1h`. Defaults to `24h`.
+        """
+        return pulumi.get(self, "previous_retention")
+
+    @property
+    @pulumi.getter
+    def promote(self) -> pulumi.Output[Optional[str]]:
+        """
+        A nonce: any change to it pins the pending string.
+        """
+        return pulumi.get(self, "promote")
+
+    @property
+    @pulumi.getter(name="promoteAfterUpdates")
+    def promote_after_updates(self) -> pulumi.Output[Optional[int]]:
+        """
+        Pin the pending string automatically on this many updates after it was staged.
+        """
+        return pulumi.get(self, "promote_after_updates")
+
+    @property
+    @pulumi.getter(name="restoreFrom")
+    def restore_from(self) -> pulumi.Output[Optional[str]]:
+        """
+        The name of a deleted resource in the same project and stack whose tombstone to restore the pinned string from on create.
+        """
+        return pulumi.get(self, "restore_from")
+
+    @property
+    @pulumi.getter
+    def result(self) -> pulumi.Output[Optional[str]]:
+        """
+        The pinned string after `transforms` have been applied.
+        """
+        return pulumi.get(self, "result")
+
+    @property
+    @pulumi.getter(name="retainOnDelete")
+    def retain_on_delete(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Write a tombstone of the pinned string to the provider's archive when the resource is deleted. Defaults to `false`.
+        """
+        return pulumi.get(self, "retain_on_delete")
+
+    @property
+    @pulumi.getter
+    def revision(self) -> pulumi.Output[Optional[int]]:
+        """
+        How many strings have been pinned, starting from 1.
+        """
+        return pulumi.get(self, "revision")
+
+    @property
+    @pulumi.getter(name="rotateOnDrift")
+    def rotate_on_drift(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Rotate when the last refresh found the source to differ from the pinned string. Defaults to `false`.
+        """
+        return pulumi.get(self, "rotate_on_drift")
+
+    @property
+    @pulumi.getter(name="rotationGroup")
+    def rotation_group(self) -> pulumi.Output[Optional[str]]:
+        """
+        A group in the provider's rotation manifest. Editing the group's entry rotates every string in the group.
+        """
+        return pulumi.get(self, "rotation_group")
+
+    @property
+    @pulumi.getter(name="rotationGroupTrigger")
+    def rotation_group_trigger(self) -> pulumi.Output[Optional[str]]:
+        """
+        The trigger value of the rotation group as of the last update.
+        """
+        return pulumi.get(self, "rotation_group_trigger")
+
+    @property
+    @pulumi.getter(name="rotationMode")
+    def rotation_mode(self) -> pulumi.Output[Optional['RotationMode']]:
+        """
+        Which input changes pin a new string. Defaults to `rotateOnTriggerChange`.
+        """
+        return pulumi.get(self, "rotation_mode")
+
+    @property
+    @pulumi.getter(name="scryptHash")
+    def scrypt_hash(self) -> pulumi.Output[Optional[str]]:
+        """
+        The scrypt hash of the pinned string in PHC format, when `hashes.scrypt` is set.
+        """
+        return pulumi.get(self, "scrypt_hash")
+
+    @property
+    @pulumi.getter(name="sensitiveTriggers")
+    def sensitive_triggers(self) -> pulumi.Output[Optional[Sequence[str]]]:
+        """
+        Triggers whose values are masked, down to their last four characters, when a diff explains what changed. Secret trigger values are always hidden.
+        """
+        return pulumi.get(self, "sensitive_triggers")
+
+    @property
+    @pulumi.getter
+    def source(self) -> pulumi.Output[Optional['outputs.Source']]:
+        """
+        Where to read the string to pin from, in place of `string`. The source is only read when the string rotates.
+        """
+        return pulumi.get(self, "source")
+
+    @property
+    @pulumi.getter(name="sourceDrift")
+    def source_drift(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Whether the source differed from the pinned string at the last refresh.
+        """
+        return pulumi.get(self, "source_drift")
+
+    @property
+    @pulumi.getter
+    def staged(self) -> pulumi.Output[Optional[bool]]:
+        """
+        Place a rotated string in `pending` and only pin it once promoted. Defaults to `false`.
+        """
+        return pulumi.get(self, "staged")
+
+    @property
+    @pulumi.getter
+    def string(self) -> pulumi.Output[Optional[str]]:
+        """
+        The string to pin. Changes are only picked up when the string rotates.
+        """
         return pulumi.get(self, "string")
 
     @property
     @pulumi.getter
-    def triggers(self) -> pulumi.Output[Mapping[str, str]]:
+    def transforms(self) -> pulumi.Output[Optional[Sequence['outputs.Transform']]]:
+        """
+        Transforms applied in order to the pinned string to compute `result`.
+        """
+        return pulumi.get(self, "transforms")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Arbitrary values that rotate the string whenever any of them is added, removed or changed. Values of any type are compared by content.
+        """
         return pulumi.get(self, "triggers")
 
+    @property
+    @pulumi.getter(name="warnBefore")
+    def warn_before(self) -> pulumi.Output[Optional[str]]:
+        """
+        How long before expiry every diff starts to warn, such as `72h`.
+        """
+        return pulumi.get(self, "warn_before")
+
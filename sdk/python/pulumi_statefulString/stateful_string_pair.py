@@ -0,0 +1,164 @@
+# coding=utf-8
+# *** WARNING: this file was generated by pulumi-language-python. ***
+# *** Do not edit by hand unless you're certain you know what you are doing! ***
+
+import copy
+import warnings
+import pulumi
+import pulumi.runtime
+from typing import Any, Mapping, Optional, Sequence, Union, overload
+from . import _utilities
+from . import outputs
+from ._enums import *
+from ._inputs import *
+
+__all__ = ['StatefulStringPairArgs', 'StatefulStringPair']
+
+@pulumi.input_type
+class StatefulStringPairArgs:
+    def __init__(__self__, *,
+                 fields: pulumi.Input[Mapping[str, pulumi.Input['PairFieldArgs']]],
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None):
+        """
+        The set of arguments for constructing a StatefulStringPair resource.
+        :param pulumi.Input[Mapping[str, pulumi.Input['PairFieldArgs']]] fields: The fields to pin, by name. At least two are required.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+        """
+        pulumi.set(__self__, "fields", fields)
+        if triggers is not None:
+            pulumi.set(__self__, "triggers", triggers)
+
+    @property
+    @pulumi.getter
+    def fields(self) -> pulumi.Input[Mapping[str, pulumi.Input['PairFieldArgs']]]:
+        """
+        The fields to pin, by name. At least two are required.
+        """
+        return pulumi.get(self, "fields")
+
+    @fields.setter
+    def fields(self, value: pulumi.Input[Mapping[str, pulumi.Input['PairFieldArgs']]]):
+        pulumi.set(self, "fields", value)
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> Optional[pulumi.Input[Mapping[str, Any]]]:
+        """
+        Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
+    @triggers.setter
+    def triggers(self, value: Optional[pulumi.Input[Mapping[str, Any]]]):
+        pulumi.set(self, "triggers", value)
+
+
+class StatefulStringPair(pulumi.CustomResource):
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 fields: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['PairFieldArgs']]]]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        """
+        Two or more named strings, such as a username and a password, that share one set of triggers and always rotate together.
+
+        :param str resource_name: The name of the resource.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        :param pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['PairFieldArgs']]]] fields: The fields to pin, by name. At least two are required.
+        :param pulumi.Input[Mapping[str, Any]] triggers: Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+        """
+        ...
+    @overload
+    def __init__(__self__,
+                 resource_name: str,
+                 args: StatefulStringPairArgs,
+                 opts: Optional[pulumi.ResourceOptions] = None):
+        """
+        Two or more named strings, such as a username and a password, that share one set of triggers and always rotate together.
+
+        :param str resource_name: The name of the resource.
+        :param StatefulStringPairArgs args: The arguments to use to populate this resource's properties.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        ...
+    def __init__(__self__, resource_name: str, *args, **kwargs):
+        resource_args, opts = _utilities.get_resource_args_opts(StatefulStringPairArgs, pulumi.ResourceOptions, *args, **kwargs)
+        if resource_args is not None:
+            __self__._internal_init(resource_name, opts, **resource_args.__dict__)
+        else:
+            __self__._internal_init(resource_name, *args, **kwargs)
+
+    def _internal_init(__self__,
+                 resource_name: str,
+                 opts: Optional[pulumi.ResourceOptions] = None,
+                 fields: Optional[pulumi.Input[Mapping[str, pulumi.Input[pulumi.InputType['PairFieldArgs']]]]] = None,
+                 triggers: Optional[pulumi.Input[Mapping[str, Any]]] = None,
+                 __props__=None):
+        opts = pulumi.ResourceOptions.merge(_utilities.get_resource_opts_defaults(), opts)
+        if not isinstance(opts, pulumi.ResourceOptions):
+            raise TypeError('Expected resource options to be a ResourceOptions instance')
+        if opts.id is None:
+            if __props__ is not None:
+                raise TypeError('__props__ is only valid when passed in combination with a valid opts.id to get an existing resource')
+            __props__ = StatefulStringPairArgs.__new__(StatefulStringPairArgs)
+
+            if fields is None and not opts.urn:
+                raise TypeError("Missing required property 'fields'")
+            __props__.__dict__["fields"] = fields
+            __props__.__dict__["triggers"] = triggers
+            __props__.__dict__["values"] = None
+        secret_opts = pulumi.ResourceOptions(additional_secret_outputs=["values"])
+        opts = pulumi.ResourceOptions.merge(opts, secret_opts)
+        super(StatefulStringPair, __self__).__init__(
+            'statefulString:index:StatefulStringPair',
+            resource_name,
+            __props__,
+            opts)
+
+    @staticmethod
+    def get(resource_name: str,
+            id: pulumi.Input[str],
+            opts: Optional[pulumi.ResourceOptions] = None) -> 'StatefulStringPair':
+        """
+        Get an existing StatefulStringPair resource's state with the given name, id, and optional extra
+        properties used to qualify the lookup.
+
+        :param str resource_name: The unique name of the resulting resource.
+        :param pulumi.Input[str] id: The unique provider ID of the resource to lookup.
+        :param pulumi.ResourceOptions opts: Options for the resource.
+        """
+        opts = pulumi.ResourceOptions.merge(opts, pulumi.ResourceOptions(id=id))
+
+        __props__ = StatefulStringPairArgs.__new__(StatefulStringPairArgs)
+
+        __props__.__dict__["fields"] = None
+        __props__.__dict__["triggers"] = None
+        __props__.__dict__["values"] = None
+        return StatefulStringPair(resource_name, opts=opts, __props__=__props__)
+
+    @property
+    @pulumi.getter
+    def fields(self) -> pulumi.Output[Mapping[str, 'outputs.PairField']]:
+        """
+        The fields to pin, by name. At least two are required.
+        """
+        return pulumi.get(self, "fields")
+
+    @property
+    @pulumi.getter
+    def triggers(self) -> pulumi.Output[Optional[Mapping[str, Any]]]:
+        """
+        Arbitrary values that rotate every field whenever any of them is added, removed or changed.
+        """
+        return pulumi.get(self, "triggers")
+
+    @property
+    @pulumi.getter
+    def values(self) -> pulumi.Output[Mapping[str, str]]:
+        """
+        The pinned value of every field, by field name.
+        """
+        return pulumi.get(self, "values")
+
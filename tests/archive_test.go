@@ -0,0 +1,127 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetainOnDelete(t *testing.T) {
+	dir := t.TempDir()
+	prov := provider()
+	err := prov.Configure(p.ConfigureRequest{
+		Args: resource.PropertyMap{
+			"archiveDir": resource.NewStringProperty(dir),
+		},
+	})
+	require.NoError(t, err)
+
+	retained := fields{"retainOnDelete": true, "restoreFrom": "db-password"}
+
+	// Nothing to restore yet, so the first resource pins its own string
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("1", retained, fields{"string": "secret-1"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), created.Properties["revision"].NumberValue())
+
+	rotated, err := prov.Update(p.UpdateRequest{
+		ID:   "db-password",
+		Urn:  urn("StatefulString"),
+		Olds: created.Properties,
+		News: inputs("2", retained, fields{"string": "secret-2"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), rotated.Properties["revision"].NumberValue())
+
+	// Deleting leaves a tombstone named after the resource, in a directory per project and stack
+	err = prov.Delete(p.DeleteRequest{
+		ID:         "db-password",
+		Urn:        urn("StatefulString"),
+		Properties: rotated.Properties,
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "proj", "stack", "db-password.json"))
+	require.NoError(t, err)
+
+	// A recreated resource recovers the deleted string instead of pinning its own
+	recreated, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("2", retained, fields{"string": "secret-3"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "secret-2", recreated.Properties["string"].StringValue())
+	assert.Equal(t, float64(2), recreated.Properties["revision"].NumberValue())
+
+	// Another stack sharing the archive has tombstones of its own
+	elsewhere, err := prov.Create(p.CreateRequest{
+		Urn:        resource.NewURN("stack.other", "proj", "", "test:index:StatefulString", "name"),
+		Properties: inputs("2", retained, fields{"string": "secret-3"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "secret-3", elsewhere.Properties["string"].StringValue())
+
+	// Without retainOnDelete nothing is archived
+	plain := rotated.Properties.Copy()
+	delete(plain, "retainOnDelete")
+	err = prov.Delete(p.DeleteRequest{
+		ID:         "other",
+		Urn:        urn("StatefulString"),
+		Properties: plain,
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "proj", "stack", "other.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	// Setting retainOnDelete on an existing resource reaches its state
+	unretained := inputs("1", fields{"string": "secret-1"})
+	existing, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: unretained,
+	})
+	require.NoError(t, err)
+	late := inputs("1", fields{"string": "secret-1", "retainOnDelete": true})
+	diff, err := prov.Diff(p.DiffRequest{
+		ID:   "late",
+		Urn:  urn("StatefulString"),
+		Olds: existing.Properties,
+		News: late,
+	})
+	require.NoError(t, err)
+	assert.True(t, diff.HasChanges)
+	updated, err := prov.Update(p.UpdateRequest{
+		ID:   "late",
+		Urn:  urn("StatefulString"),
+		Olds: existing.Properties,
+		News: late,
+	})
+	require.NoError(t, err)
+	err = prov.Delete(p.DeleteRequest{
+		ID:         "late",
+		Urn:        urn("StatefulString"),
+		Properties: updated.Properties,
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "proj", "stack", "late.json"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,172 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/pulumi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	statefulString "github.com/pulumi/pulumi-statefulstring/provider"
+)
+
+// bundleMocks creates the StatefulString a SecretBundle registers with the provider
+// itself, as the engine would, and records the outputs the bundle asked to be secret.
+type bundleMocks struct {
+	secretOutputs *[]string
+}
+
+func (m bundleMocks) NewResource(args pulumi.MockResourceArgs) (string, resource.PropertyMap, error) {
+	if args.TypeToken != "statefulString:index:StatefulString" {
+		return args.Name, args.Inputs, nil
+	}
+	if m.secretOutputs != nil && args.RegisterRPC != nil {
+		*m.secretOutputs = args.RegisterRPC.GetAdditionalSecretOutputs()
+	}
+	created, err := provider().Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: args.Inputs,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return created.ID, created.Properties, nil
+}
+
+func (m bundleMocks) Call(args pulumi.MockCallArgs) (resource.PropertyMap, error) {
+	return args.Args, nil
+}
+
+// bundleOutputs are the resolved outputs of a SecretBundle.
+type bundleOutputs struct {
+	value    string
+	variants map[string]string
+	sha256   string
+	revision int
+	bcrypt   *string
+	// Whether the value and its digest are secret
+	valueSecret, sha256Secret bool
+	// The outputs of the StatefulString the bundle asked to be secret
+	secretOutputs []string
+}
+
+func constructBundle(t *testing.T, args statefulString.SecretBundleArgs) bundleOutputs {
+	var out bundleOutputs
+	var secretOutputs []string
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		bundle, err := statefulString.SecretBundle{}.Construct(ctx, "bundle",
+			"statefulString:index:SecretBundle", args, pulumi.Protect(false))
+		if err != nil {
+			return err
+		}
+		var wg sync.WaitGroup
+		wg.Add(1)
+		pulumi.All(bundle.Value, bundle.Variants, bundle.Sha256, bundle.Revision,
+			bundle.BcryptHash).ApplyT(func(all []any) error {
+			defer wg.Done()
+			out = bundleOutputs{
+				value:    all[0].(string),
+				variants: all[1].(map[string]string),
+				sha256:   all[2].(string),
+				revision: all[3].(int),
+				bcrypt:   all[4].(*string),
+			}
+			return nil
+		})
+		wg.Wait()
+		out.valueSecret = pulumi.IsSecret(bundle.Value)
+		out.sha256Secret = pulumi.IsSecret(bundle.Sha256)
+		return nil
+	}, pulumi.WithMocks("proj", "stack", bundleMocks{secretOutputs: &secretOutputs}))
+	require.NoError(t, err)
+	out.secretOutputs = secretOutputs
+	return out
+}
+
+func TestSecretBundle(t *testing.T) {
+	length := 12
+	digits := "0123456789"
+	seed := "test"
+	cost := 4
+	args := statefulString.SecretBundleArgs{
+		Triggers: pulumi.Map{"foo": pulumi.String("1")},
+		Generator: &statefulString.Generator{
+			Kind: statefulString.GeneratorRandom,
+			Seed: &seed,
+		},
+		Variants: map[string]statefulString.BundleVariant{
+			"pin":   {Length: &length, Charset: &digits},
+			"plain": {},
+		},
+		Hashes: &statefulString.HashOptions{
+			Bcrypt: &statefulString.BcryptOptions{Cost: &cost},
+		},
+	}
+
+	bundle := constructBundle(t, args)
+	assert.Len(t, bundle.value, 32)
+	assert.Regexp(t, "^[0-9]{12}$", bundle.variants["pin"])
+	assert.Regexp(t, "^[a-zA-Z0-9]{32}$", bundle.variants["plain"])
+	assert.NotEqual(t, bundle.value, bundle.variants["plain"])
+	sum := sha256.Sum256([]byte(bundle.value))
+	assert.Equal(t, hex.EncodeToString(sum[:]), bundle.sha256)
+	assert.True(t, bundle.valueSecret)
+	assert.True(t, bundle.sha256Secret)
+	assert.ElementsMatch(t, []string{"string", "result", "current", "desiredString", "previous", "pending"},
+		bundle.secretOutputs)
+	assert.Equal(t, 1, bundle.revision)
+	require.NotNil(t, bundle.bcrypt)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(*bundle.bcrypt), []byte(bundle.value)))
+
+	// Everything else is derived from the pinned value, so the same value derives the
+	// same bundle; only the salted hash differs
+	again := constructBundle(t, args)
+	again.bcrypt = bundle.bcrypt
+	assert.Equal(t, bundle, again)
+
+	// Bad variants fail before anything is registered
+	empty := ""
+	args.Variants = map[string]statefulString.BundleVariant{"bad": {Charset: &empty}}
+	err := pulumi.RunErr(func(ctx *pulumi.Context) error {
+		_, err := statefulString.SecretBundle{}.Construct(ctx, "bundle",
+			"statefulString:index:SecretBundle", args, pulumi.Protect(false))
+		return err
+	}, pulumi.WithMocks("proj", "stack", bundleMocks{}))
+	assert.ErrorContains(t, err, `variant "bad": charset must not be empty`)
+
+	// So does a derive generator without the provider's deriveSeed, which the bundle can only
+	// read when the provider runs it
+	args.Variants = nil
+	args.Generator = &statefulString.Generator{Kind: statefulString.GeneratorDerive}
+	_, err = provider().Construct(p.ConstructRequest{
+		URN: urn("SecretBundle"),
+		Construct: func(pctx p.Context, _ p.ConstructFunc) (p.ConstructResponse, error) {
+			ctx, err := pulumi.NewContext(pctx, pulumi.RunInfo{Project: "proj", Stack: "stack", Mocks: bundleMocks{}})
+			require.NoError(t, err)
+			_, err = statefulString.SecretBundle{}.Construct(ctx, "bundle",
+				"statefulString:index:SecretBundle", args, pulumi.Protect(false))
+			return p.ConstructResponse{}, err
+		},
+	})
+	assert.ErrorContains(t, err, "generator: the derive generator requires the provider's deriveSeed config")
+}
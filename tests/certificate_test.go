@@ -0,0 +1,201 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificate(t *testing.T) {
+	prov := provider()
+
+	// The subject and DNS names of a certificate for name
+	named := func(name string) fields {
+		return fields{"subject": fields{"commonName": name}, "dnsNames": []string{name}}
+	}
+	create := func(props resource.PropertyMap) resource.PropertyMap {
+		created, err := prov.Create(p.CreateRequest{
+			Urn:        urn("StatefulCertificate"),
+			Properties: props,
+		})
+		require.NoError(t, err)
+		return created.Properties
+	}
+	parse := func(props resource.PropertyMap) *x509.Certificate {
+		block, _ := pem.Decode([]byte(props["certPem"].StringValue()))
+		require.NotNil(t, block)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+		sum := sha256.Sum256(block.Bytes)
+		assert.Equal(t, hex.EncodeToString(sum[:]), props["fingerprintSha256"].StringValue())
+		return cert
+	}
+
+	// A self-signed CA signs a leaf certificate
+	ca := create(inputs("1", named("ca.test"), fields{"isCa": true}))
+	caCert := parse(ca)
+	assert.True(t, caCert.IsCA)
+	assert.NoError(t, caCert.CheckSignatureFrom(caCert))
+
+	leafFields := fields{
+		"ipAddresses":     []string{"127.0.0.1"},
+		"keyAlgorithm":    "ed25519",
+		"validFor":        "48h",
+		"renewBefore":     "1h",
+		"caCertPem":       ca["certPem"],
+		"caPrivateKeyPem": secretString(t, ca, "privateKeyPem"),
+	}
+	leafProps := inputs("1", named("app.test"), leafFields)
+	leaf := create(leafProps)
+	leafCert := parse(leaf)
+	assert.Equal(t, "app.test", leafCert.Subject.CommonName)
+	assert.Equal(t, []string{"app.test"}, leafCert.DNSNames)
+	assert.Equal(t, "127.0.0.1", leafCert.IPAddresses[0].String())
+	assert.Equal(t, 48*time.Hour, leafCert.NotAfter.Sub(leafCert.NotBefore))
+	assert.NoError(t, leafCert.CheckSignatureFrom(caCert))
+
+	block, _ := pem.Decode([]byte(secretString(t, leaf, "privateKeyPem")))
+	require.NotNil(t, block)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, leafCert.PublicKey, key.(crypto.Signer).Public())
+	secretString(t, leaf, "caPrivateKeyPem")
+
+	// A SAN change renews the certificate like a trigger
+	renamed := inputs("1", leafFields, named("api.test"))
+	// So does nearing notAfter
+	due := leaf.Copy()
+	due["notAfter"] = resource.NewStringProperty(time.Now().Add(30 * time.Minute).UTC().Format(time.RFC3339))
+
+	for _, tc := range []struct {
+		name         string
+		olds         resource.PropertyMap
+		news         resource.PropertyMap
+		expectedDiff map[string]p.PropertyDiff
+		check        func(t *testing.T, updated resource.PropertyMap)
+	}{
+		{
+			// A certificate outside its renewal window is kept
+			name:         "Outside the renewal window",
+			olds:         leaf,
+			news:         leafProps,
+			expectedDiff: map[string]p.PropertyDiff{},
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.Equal(t, leaf["certPem"], updated["certPem"])
+			},
+		},
+		{
+			// A new renewal window is stored without renewing
+			name:         "renewBefore change",
+			olds:         leaf,
+			news:         inputs("1", named("app.test"), leafFields, fields{"renewBefore": "2h"}),
+			expectedDiff: map[string]p.PropertyDiff{"renewBefore": {Kind: p.Update}},
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.Equal(t, leaf["certPem"], updated["certPem"])
+				assert.Equal(t, "2h", updated["renewBefore"].StringValue())
+			},
+		},
+		{
+			name: "SAN change",
+			olds: leaf,
+			news: renamed,
+			expectedDiff: map[string]p.PropertyDiff{
+				"subject.commonName": {Kind: p.Update},
+				"dnsNames":           {Kind: p.Update},
+			},
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.Equal(t, []string{"api.test"}, parse(updated).DNSNames)
+			},
+		},
+		{
+			name: "Nearing notAfter",
+			olds: due,
+			news: leafProps,
+			expectedDiff: map[string]p.PropertyDiff{
+				"notAfter": {Kind: p.Update},
+			},
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.NotEqual(t, leaf["certPem"], updated["certPem"])
+				notAfter, err := time.Parse(time.RFC3339, updated["notAfter"].StringValue())
+				require.NoError(t, err)
+				assert.WithinDuration(t, time.Now().Add(48*time.Hour), notAfter, time.Minute)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := prov.Diff(p.DiffRequest{
+				Urn:  urn("StatefulCertificate"),
+				Olds: tc.olds,
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDiff, diff.DetailedDiff)
+
+			updated, err := prov.Update(p.UpdateRequest{
+				ID:   "name",
+				Urn:  urn("StatefulCertificate"),
+				Olds: tc.olds,
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			tc.check(t, updated.Properties)
+		})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		news     resource.PropertyMap
+		failures []p.CheckFailure
+	}{
+		{
+			name: "Invalid",
+			news: inputs("1", named("bad.test"), fields{
+				"ipAddresses": []string{"nope"},
+				"validFor":    "1h",
+				"caCertPem":   ca["certPem"],
+			}),
+			failures: []p.CheckFailure{
+				{Property: "ipAddresses", Reason: `"nope" is not an IP address`},
+				{Property: "renewBefore", Reason: "renewBefore must be shorter than validFor (1h0m0s)"},
+				{Property: "caCertPem", Reason: "caCertPem and caPrivateKeyPem must be set together"},
+			},
+		},
+		{
+			name:     "Valid",
+			news:     leafProps,
+			failures: nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := prov.Check(p.CheckRequest{
+				Urn:  urn("StatefulCertificate"),
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.failures, response.Failures)
+		})
+	}
+}
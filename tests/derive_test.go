@@ -0,0 +1,122 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveGenerator(t *testing.T) {
+	configured := func(seed string) integration.Server {
+		prov := provider()
+		err := prov.Configure(p.ConfigureRequest{
+			Args: resource.PropertyMap{
+				"deriveSeed": resource.NewStringProperty(seed),
+			},
+		})
+		require.NoError(t, err)
+		return prov
+	}
+	derive := fields{"generator": fields{"kind": "derive", "length": 20, "charset": "abcdef0123456789"}}
+	create := func(prov integration.Server, trigger string) resource.PropertyMap {
+		created, err := prov.Create(p.CreateRequest{
+			Urn:        urn("StatefulString"),
+			Properties: inputs(trigger, derive),
+		})
+		require.NoError(t, err)
+		return created.Properties
+	}
+
+	// Rebuilding from scratch with the same seed and triggers derives the same string
+	first := create(configured("seed-1"), "1")
+	derived := secretString(t, first, "string")
+	assert.Regexp(t, "^[a-f0-9]{20}$", derived)
+	for _, tc := range []struct {
+		name    string
+		seed    string
+		trigger string
+		same    bool
+	}{
+		{name: "Same seed", seed: "seed-1", trigger: "1", same: true},
+		{name: "Other seed", seed: "seed-2", trigger: "1", same: false},
+		{name: "Other trigger", seed: "seed-1", trigger: "2", same: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rebuilt := secretString(t, create(configured(tc.seed), tc.trigger), "string")
+			assert.Equal(t, tc.same, derived == rebuilt)
+		})
+	}
+
+	// A trigger change derives a new string, and changing it back derives the old one
+	t.Run("Rotation", func(t *testing.T) {
+		prov := configured("seed-1")
+		olds := first
+		for _, step := range []struct {
+			trigger string
+			same    bool
+		}{
+			{trigger: "2", same: false},
+			{trigger: "1", same: true},
+		} {
+			updated, err := prov.Update(p.UpdateRequest{
+				ID:   "name",
+				Urn:  urn("StatefulString"),
+				Olds: olds,
+				News: inputs(step.trigger, derive),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, step.same, derived == secretString(t, updated.Properties, "string"))
+			assert.Equal(t, updated.Properties["string"], updated.Properties["desiredString"])
+			assert.False(t, updated.Properties["isStale"].BoolValue())
+			olds = updated.Properties
+		}
+	})
+
+	// Deriving needs a seed, and replaces a literal string
+	for _, tc := range []struct {
+		name   string
+		prov   integration.Server
+		news   resource.PropertyMap
+		reason string
+	}{
+		{
+			name:   "No seed",
+			prov:   provider(),
+			news:   inputs("1", derive),
+			reason: "the derive generator requires the provider's deriveSeed config",
+		},
+		{
+			name:   "Literal string",
+			prov:   configured("seed-1"),
+			news:   inputs("1", derive, fields{"string": "literal"}),
+			reason: "string and generator are mutually exclusive",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := tc.prov.Check(p.CheckRequest{
+				Urn:  urn("StatefulString"),
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []p.CheckFailure{{Property: "generator", Reason: tc.reason}}, response.Failures)
+		})
+	}
+}
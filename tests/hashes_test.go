@@ -0,0 +1,178 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHashes(t *testing.T) {
+	prov := provider()
+
+	// Cheap parameters keep the test fast
+	hashes := func(bcryptCost int) fields {
+		return fields{"hashes": fields{
+			"bcrypt": fields{"cost": bcryptCost},
+			"argon2": fields{"memory": 64, "threads": 1},
+			"scrypt": fields{"logN": 4},
+		}}
+	}
+	update := func(olds, news resource.PropertyMap) resource.PropertyMap {
+		updated, err := prov.Update(p.UpdateRequest{
+			ID:   "name",
+			Urn:  urn("StatefulString"),
+			Olds: olds,
+			News: news,
+		})
+		require.NoError(t, err)
+		return updated.Properties
+	}
+	plain := inputs("2", fields{"string": "secret-2"})
+
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("1", hashes(4), fields{"string": "secret-1"}),
+	})
+	require.NoError(t, err)
+	first := created.Properties
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(secretString(t, first, "bcryptHash")), []byte("secret-1")))
+	assert.Regexp(t, `^\$argon2id\$v=19\$m=64,t=1,p=1\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`,
+		secretString(t, first, "argon2Hash"))
+	assert.Regexp(t, `^\$scrypt\$ln=4,r=8,p=1\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`,
+		secretString(t, first, "scryptHash"))
+
+	for _, tc := range []struct {
+		name  string
+		news  resource.PropertyMap
+		check func(t *testing.T, updated resource.PropertyMap)
+	}{
+		{
+			// Hashes are salted, so they are kept as long as the pinned string is
+			name: "String change",
+			news: inputs("1", hashes(4), fields{"string": "secret-2"}),
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				for _, key := range []resource.PropertyKey{"bcryptHash", "argon2Hash", "scryptHash"} {
+					assert.Equal(t, secretString(t, first, key), secretString(t, updated, key))
+				}
+			},
+		},
+		{
+			// New options only recompute the hash they select
+			name: "New cost",
+			news: inputs("1", hashes(5), fields{"string": "secret-1"}),
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.NotEqual(t, secretString(t, first, "bcryptHash"), secretString(t, updated, "bcryptHash"))
+				assert.NoError(t, bcrypt.CompareHashAndPassword(
+					[]byte(secretString(t, updated, "bcryptHash")), []byte("secret-1")))
+				assert.Equal(t, secretString(t, first, "argon2Hash"), secretString(t, updated, "argon2Hash"))
+				assert.Equal(t, secretString(t, first, "scryptHash"), secretString(t, updated, "scryptHash"))
+			},
+		},
+		{
+			// A rotation rehashes the new string
+			name: "Rotation",
+			news: inputs("2", hashes(4), fields{"string": "secret-2"}),
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.NoError(t, bcrypt.CompareHashAndPassword(
+					[]byte(secretString(t, updated, "bcryptHash")), []byte("secret-2")))
+				assert.NotEqual(t, secretString(t, first, "argon2Hash"), secretString(t, updated, "argon2Hash"))
+				assert.NotEqual(t, secretString(t, first, "scryptHash"), secretString(t, updated, "scryptHash"))
+			},
+		},
+		{
+			// Dropping the options drops the hashes
+			name: "No options",
+			news: plain,
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				for _, key := range []resource.PropertyKey{"bcryptHash", "argon2Hash", "scryptHash"} {
+					assert.NotContains(t, updated, key)
+				}
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(t, update(first, tc.news))
+		})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		news     resource.PropertyMap
+		failures []p.CheckFailure
+	}{
+		{
+			name: "bcrypt cost",
+			news: inputs("1", hashes(3), fields{"string": "secret-1"}),
+			failures: []p.CheckFailure{{
+				Property: "hashes.bcrypt.cost",
+				Reason:   "cost must be between 4 and 16, got 3",
+			}},
+		},
+		{
+			name: "bcrypt upper bound",
+			news: inputs("1", hashes(17), fields{"string": "secret-1"}),
+			failures: []p.CheckFailure{{
+				Property: "hashes.bcrypt.cost",
+				Reason:   "cost must be between 4 and 16, got 17",
+			}},
+		},
+		{
+			name: "scrypt memory",
+			news: inputs("1", fields{"string": "secret-1", "hashes": fields{
+				"scrypt": fields{"logN": 20, "r": 16},
+			}}),
+			failures: []p.CheckFailure{{
+				Property: "hashes.scrypt",
+				Reason:   "128*r*N must be at most 1073741824 bytes, got r=16 N=2^20",
+			}},
+		},
+		{
+			name: "argon2 upper bounds",
+			news: inputs("1", fields{"string": "secret-1", "hashes": fields{
+				"argon2": fields{"time": 11, "memory": 1 << 32, "threads": 256},
+			}}),
+			failures: []p.CheckFailure{
+				{Property: "hashes.argon2.time", Reason: "time must be between 1 and 10, got 11"},
+				{Property: "hashes.argon2.threads", Reason: "threads must be between 1 and 255, got 256"},
+				{Property: "hashes.argon2.memory", Reason: "memory must be at most 4194304 KiB, got 4294967296"},
+			},
+		},
+		{
+			name: "argon2 lower bounds",
+			news: inputs("1", fields{"string": "secret-1", "hashes": fields{
+				"argon2": fields{"time": 0, "memory": 8, "threads": 2},
+			}}),
+			failures: []p.CheckFailure{
+				{Property: "hashes.argon2.time", Reason: "time must be between 1 and 10, got 0"},
+				{Property: "hashes.argon2.memory", Reason: "memory must be at least 8 KiB per thread, got 8"},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := prov.Check(p.CheckRequest{
+				Urn:  urn("StatefulString"),
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.failures, response.Failures)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestKeyPair(t *testing.T) {
+	prov := provider()
+
+	for _, tc := range []struct {
+		algorithm string
+		extra     fields
+		sshType   string
+	}{
+		{"ed25519", nil, ssh.KeyAlgoED25519},
+		{"rsa", fields{"rsaBits": 2048}, ssh.KeyAlgoRSA},
+		{"ecdsa", fields{"ecdsaCurve": "P384"}, ssh.KeyAlgoECDSA384},
+	} {
+		t.Run(tc.algorithm, func(t *testing.T) {
+			created, err := prov.Create(p.CreateRequest{
+				Urn:        urn("StatefulKeyPair"),
+				Properties: inputs("1", fields{"algorithm": tc.algorithm}, tc.extra),
+			})
+			require.NoError(t, err)
+
+			block, _ := pem.Decode([]byte(secretString(t, created.Properties, "privateKeyPem")))
+			require.NotNil(t, block)
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			require.NoError(t, err)
+			signer, err := ssh.NewSignerFromKey(key)
+			require.NoError(t, err)
+			assert.Equal(t, tc.sshType, signer.PublicKey().Type())
+
+			parsed, err := ssh.ParsePrivateKey([]byte(secretString(t, created.Properties, "privateKeyOpenssh")))
+			require.NoError(t, err)
+			assert.Equal(t, signer.PublicKey().Marshal(), parsed.PublicKey().Marshal())
+
+			public, _, _, _, err := ssh.ParseAuthorizedKey([]byte(created.Properties["publicKeyOpenssh"].StringValue()))
+			require.NoError(t, err)
+			assert.Equal(t, signer.PublicKey().Marshal(), public.Marshal())
+			assert.Equal(t, ssh.FingerprintSHA256(public), created.Properties["fingerprintSha256"].StringValue())
+			assert.Contains(t, created.Properties["publicKeyPem"].StringValue(), "-----BEGIN PUBLIC KEY-----")
+		})
+	}
+
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulKeyPair"),
+		Properties: inputs("1", fields{"algorithm": "ed25519"}),
+	})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		name         string
+		news         resource.PropertyMap
+		expectedDiff map[string]p.PropertyDiff
+		check        func(t *testing.T, updated resource.PropertyMap)
+	}{
+		{
+			// New key settings are not a change on their own; the pinned key is kept
+			name:         "New settings",
+			news:         inputs("1", fields{"algorithm": "ecdsa"}),
+			expectedDiff: map[string]p.PropertyDiff{},
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.Equal(t, created.Properties, updated)
+			},
+		},
+		{
+			// A trigger change regenerates the key with the current settings
+			name: "Trigger change",
+			news: inputs("2", fields{"algorithm": "ecdsa"}),
+			expectedDiff: map[string]p.PropertyDiff{
+				"triggers.foo":  {Kind: p.Update},
+				"privateKeyPem": {Kind: p.Update},
+			},
+			check: func(t *testing.T, updated resource.PropertyMap) {
+				assert.NotEqual(t, created.Properties["fingerprintSha256"], updated["fingerprintSha256"])
+				assert.Equal(t, "ecdsa", updated["algorithm"].StringValue())
+				assert.Regexp(t, "^ecdsa-sha2-nistp256 ", updated["publicKeyOpenssh"].StringValue())
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := prov.Diff(p.DiffRequest{
+				Urn:  urn("StatefulKeyPair"),
+				Olds: created.Properties,
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedDiff, diff.DetailedDiff)
+
+			updated, err := prov.Update(p.UpdateRequest{
+				ID:   "name",
+				Urn:  urn("StatefulKeyPair"),
+				Olds: created.Properties,
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			tc.check(t, updated.Properties)
+		})
+	}
+
+	for _, tc := range []struct {
+		name     string
+		news     resource.PropertyMap
+		failures []p.CheckFailure
+	}{
+		{
+			name: "Small rsaBits",
+			news: inputs("1", fields{"algorithm": "rsa", "rsaBits": 1024}),
+			failures: []p.CheckFailure{{
+				Property: "rsaBits",
+				Reason:   "rsaBits must be one of [2048 3072 4096], got 1024",
+			}},
+		},
+		{
+			name: "Odd rsaBits",
+			news: inputs("1", fields{"algorithm": "rsa", "rsaBits": 2049}),
+			failures: []p.CheckFailure{{
+				Property: "rsaBits",
+				Reason:   "rsaBits must be one of [2048 3072 4096], got 2049",
+			}},
+		},
+		{
+			name: "Large rsaBits",
+			news: inputs("1", fields{"algorithm": "rsa", "rsaBits": 16384}),
+			failures: []p.CheckFailure{{
+				Property: "rsaBits",
+				Reason:   "rsaBits must be one of [2048 3072 4096], got 16384",
+			}},
+		},
+		{
+			name:     "rsaBits 3072",
+			news:     inputs("1", fields{"algorithm": "rsa", "rsaBits": 3072}),
+			failures: nil,
+		},
+		{
+			name: "rsaBits on ed25519",
+			news: inputs("1", fields{"algorithm": "ed25519", "rsaBits": 2048}),
+			failures: []p.CheckFailure{{
+				Property: "rsaBits",
+				Reason:   "rsaBits only applies to rsa keys",
+			}},
+		},
+		{
+			name: "ecdsaCurve on rsa",
+			news: inputs("1", fields{"algorithm": "rsa", "ecdsaCurve": "P256"}),
+			failures: []p.CheckFailure{{
+				Property: "ecdsaCurve",
+				Reason:   "ecdsaCurve only applies to ecdsa keys",
+			}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := prov.Check(p.CheckRequest{
+				Urn:  urn("StatefulKeyPair"),
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.failures, response.Failures)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatefulStringPair(t *testing.T) {
+	prov := provider()
+
+	// A pair of a fixed username and a generated password
+	pair := func(username string) fields {
+		return fields{"fields": fields{
+			"username": fields{"value": username},
+			"password": fields{"generator": fields{"kind": "random", "length": 24}},
+		}}
+	}
+	values := func(props resource.PropertyMap) resource.PropertyMap {
+		v := props["values"]
+		require.True(t, v.IsSecret(), "values must be secret")
+		return v.SecretValue().Element.ObjectValue()
+	}
+
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulStringPair"),
+		Properties: inputs("1", pair("app-1")),
+	})
+	require.NoError(t, err)
+	first := values(created.Properties)
+	assert.Equal(t, "app-1", first["username"].StringValue())
+	assert.Len(t, first["password"].StringValue(), 24)
+
+	// Without a trigger change neither field moves
+	diff, err := prov.Diff(p.DiffRequest{
+		Urn:  urn("StatefulStringPair"),
+		Olds: created.Properties,
+		News: inputs("1", pair("app-2")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"fields.username": {Kind: p.DiffKind("update")},
+	}, diff.DetailedDiff)
+
+	updated, err := prov.Update(p.UpdateRequest{
+		Urn:  urn("StatefulStringPair"),
+		Olds: created.Properties,
+		News: inputs("1", pair("app-2")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, first, values(updated.Properties))
+
+	// A trigger change rotates every field in one update
+	diff, err = prov.Diff(p.DiffRequest{
+		Urn:  urn("StatefulStringPair"),
+		Olds: updated.Properties,
+		News: inputs("2", pair("app-2")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"triggers.foo": {Kind: p.DiffKind("update")},
+		"values":       {Kind: p.DiffKind("update")},
+	}, diff.DetailedDiff)
+
+	rotated, err := prov.Update(p.UpdateRequest{
+		Urn:  urn("StatefulStringPair"),
+		Olds: updated.Properties,
+		News: inputs("2", pair("app-2")),
+	})
+	require.NoError(t, err)
+	second := values(rotated.Properties)
+	assert.Equal(t, "app-2", second["username"].StringValue())
+	assert.Len(t, second["password"].StringValue(), 24)
+	assert.NotEqual(t, first["password"], second["password"])
+}
+
+func TestStatefulStringPairCheck(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("StatefulStringPair"),
+		News: resource.PropertyMap{
+			"fields": resource.NewObjectProperty(resource.PropertyMap{
+				"keyId": resource.NewObjectProperty(resource.PropertyMap{}),
+			}),
+			"triggers": resource.NewObjectProperty(resource.PropertyMap{}),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []p.CheckFailure{
+		{Property: "fields", Reason: "a pair needs at least 2 fields, got 1"},
+		{Property: "fields.keyId", Reason: "exactly one of value and generator must be set"},
+	}, response.Failures)
+}
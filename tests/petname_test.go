@@ -0,0 +1,118 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetnameGenerator(t *testing.T) {
+	seeded := fields{"generator": fields{
+		"kind":      "petname",
+		"words":     3,
+		"separator": "_",
+		"maxLength": 20,
+		"seed":      "test",
+	}}
+	unseeded := fields{"generator": fields{"kind": "petname"}}
+	create := func(props resource.PropertyMap) string {
+		created, err := provider().Create(p.CreateRequest{
+			Urn:        urn("StatefulString"),
+			Properties: props,
+		})
+		require.NoError(t, err)
+		return secretString(t, created.Properties, "string")
+	}
+
+	for _, tc := range []struct {
+		name  string
+		check func(t *testing.T, name string)
+	}{
+		{
+			// Two adjectives and a noun, joined and kept within maxLength
+			name: "Words",
+			check: func(t *testing.T, name string) {
+				assert.Regexp(t, "^[a-z]+_[a-z]+_[a-z]+$", name)
+				assert.LessOrEqual(t, len(name), 20)
+			},
+		},
+		{
+			// A seed makes the name reproducible, for the same triggers only
+			name: "Seeded",
+			check: func(t *testing.T, name string) {
+				assert.Equal(t, name, create(inputs("1", seeded)))
+				assert.NotEqual(t, name, create(inputs("2", seeded)))
+			},
+		},
+		{
+			// Unseeded names still follow the defaults
+			name: "Unseeded",
+			check: func(t *testing.T, name string) {
+				assert.Regexp(t, "^[a-z]+-[a-z]+$", create(inputs("1", unseeded)))
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.check(t, create(inputs("1", seeded)))
+		})
+	}
+
+	// The name is only regenerated when a trigger changes
+	prov := provider()
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("1", unseeded),
+	})
+	require.NoError(t, err)
+	kept, err := prov.Update(p.UpdateRequest{
+		ID:   "name",
+		Urn:  urn("StatefulString"),
+		Olds: created.Properties,
+		News: inputs("1", unseeded),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, created.Properties["string"], kept.Properties["string"])
+
+	for _, tc := range []struct {
+		name      string
+		generator fields
+		reason    string
+	}{
+		{
+			name:      "Too short",
+			generator: fields{"kind": "petname", "words": 3, "maxLength": 6},
+			reason:    "maxLength 6 is too short for 3 words",
+		},
+		{
+			name:      "Length",
+			generator: fields{"kind": "petname", "length": 8},
+			reason:    "length and charset do not apply to a petname generator",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := provider().Check(p.CheckRequest{
+				Urn:  urn("StatefulString"),
+				News: inputs("1", fields{"generator": tc.generator}),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, []p.CheckFailure{{Property: "generator", Reason: tc.reason}}, response.Failures)
+		})
+	}
+}
@@ -15,7 +15,11 @@
 package tests
 
 import (
+	"encoding/json"
+	"io"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/blang/semver"
 	p "github.com/pulumi/pulumi-go-provider"
@@ -77,6 +81,95 @@ func TestCreate(t *testing.T) {
 					"triggers": resource.NewObjectProperty(resource.PropertyMap{
 						"foo": resource.NewStringProperty("bar"),
 					}),
+					"result":        resource.NewStringProperty("hello, world"),
+					"desiredString": resource.NewStringProperty("hello, world"),
+					"isStale":       resource.NewBoolProperty(false),
+					"current":       resource.NewStringProperty("hello, world"),
+					"revision":      resource.NewNumberProperty(1),
+				},
+			},
+		},
+		{
+			name: "Transforms applied in order",
+			properties: p.CreateRequest{
+				Urn: urn("StatefulString"),
+				Properties: resource.PropertyMap{
+					"string": resource.NewStringProperty("Hello, World"),
+					"triggers": resource.NewObjectProperty(resource.PropertyMap{
+						"foo": resource.NewStringProperty("bar"),
+					}),
+					"transforms": resource.NewArrayProperty([]resource.PropertyValue{
+						resource.NewObjectProperty(resource.PropertyMap{
+							"kind": resource.NewStringProperty("slugify"),
+						}),
+						resource.NewObjectProperty(resource.PropertyMap{
+							"kind":  resource.NewStringProperty("prefix"),
+							"value": resource.NewStringProperty("app-"),
+						}),
+						resource.NewObjectProperty(resource.PropertyMap{
+							"kind":   resource.NewStringProperty("truncate"),
+							"length": resource.NewNumberProperty(9),
+						}),
+					}),
+				},
+				Preview: false,
+			},
+			expectedResult: p.CreateResponse{
+				ID: "StatefulString",
+				Properties: map[resource.PropertyKey]resource.PropertyValue{
+					"string": resource.NewStringProperty("Hello, World"),
+					"triggers": resource.NewObjectProperty(resource.PropertyMap{
+						"foo": resource.NewStringProperty("bar"),
+					}),
+					"transforms": resource.NewArrayProperty([]resource.PropertyValue{
+						resource.NewObjectProperty(resource.PropertyMap{
+							"kind": resource.NewStringProperty("slugify"),
+						}),
+						resource.NewObjectProperty(resource.PropertyMap{
+							"kind":  resource.NewStringProperty("prefix"),
+							"value": resource.NewStringProperty("app-"),
+						}),
+						resource.NewObjectProperty(resource.PropertyMap{
+							"kind":   resource.NewStringProperty("truncate"),
+							"length": resource.NewNumberProperty(9),
+						}),
+					}),
+					"result":        resource.NewStringProperty("app-hello"),
+					"desiredString": resource.NewStringProperty("Hello, World"),
+					"isStale":       resource.NewBoolProperty(false),
+					"current":       resource.NewStringProperty("Hello, World"),
+					"revision":      resource.NewNumberProperty(1),
+				},
+			},
+		},
+		{
+			name: "JSON schema exposes parsed document",
+			properties: p.CreateRequest{
+				Urn: urn("StatefulString"),
+				Properties: resource.PropertyMap{
+					"string":     resource.NewStringProperty(`{"name": "db", "ports": [5432]}`),
+					"triggers":   resource.NewObjectProperty(resource.PropertyMap{}),
+					"jsonSchema": resource.NewStringProperty(`{"type": "object"}`),
+				},
+				Preview: false,
+			},
+			expectedResult: p.CreateResponse{
+				ID: "StatefulString",
+				Properties: map[resource.PropertyKey]resource.PropertyValue{
+					"string":     resource.NewStringProperty(`{"name": "db", "ports": [5432]}`),
+					"triggers":   resource.NewObjectProperty(resource.PropertyMap{}),
+					"jsonSchema": resource.NewStringProperty(`{"type": "object"}`),
+					"result":     resource.NewStringProperty(`{"name": "db", "ports": [5432]}`),
+					"parsed": resource.NewObjectProperty(resource.PropertyMap{
+						"name": resource.NewStringProperty("db"),
+						"ports": resource.NewArrayProperty([]resource.PropertyValue{
+							resource.NewNumberProperty(5432),
+						}),
+					}),
+					"desiredString": resource.NewStringProperty(`{"name": "db", "ports": [5432]}`),
+					"isStale":       resource.NewBoolProperty(false),
+					"current":       resource.NewStringProperty(`{"name": "db", "ports": [5432]}`),
+					"revision":      resource.NewNumberProperty(1),
 				},
 			},
 		},
@@ -376,8 +469,12 @@ func TestDiff(t *testing.T) {
 				}),
 			},
 			expectedResult: ExpectedDiffResult{
-				HasChanges:   false,
-				DetailedDiff: map[string]p.PropertyDiff{},
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"desiredString": {
+						Kind: p.DiffKind("update"),
+					},
+				},
 			},
 		},
 		{
@@ -546,6 +643,40 @@ func TestDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "String Same__Triggers Same__Inputs Change",
+			initialProps: resource.PropertyMap{
+				"string": resource.NewStringProperty("1"),
+				"triggers": resource.NewObjectProperty(resource.PropertyMap{
+					"foo": resource.NewStringProperty("bar"),
+				}),
+				"pattern": resource.NewStringProperty("^[0-9]+$"),
+			},
+			updatedProps: resource.PropertyMap{
+				"string": resource.NewStringProperty("1"),
+				"triggers": resource.NewObjectProperty(resource.PropertyMap{
+					"foo": resource.NewStringProperty("bar"),
+				}),
+				"sensitiveTriggers": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewStringProperty("foo"),
+				}),
+				"retainOnDelete": resource.NewBoolProperty(true),
+			},
+			expectedResult: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"sensitiveTriggers": {
+						Kind: p.DiffKind("update"),
+					},
+					"retainOnDelete": {
+						Kind: p.DiffKind("update"),
+					},
+					"pattern": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+		},
 		// Add more test cases here
 	}
 
@@ -566,186 +697,842 @@ func TestDiff(t *testing.T) {
 	}
 }
 
-// func TestCheck(t *testing.T) {
-// 	t.Log("Starting TestCheck")
-// 	prov := provider()
-
-// 	testCases := []struct {
-// 		name           string
-// 		request        p.CheckRequest
-// 		expectedResult p.CheckResponse
-// 	}{
-// 		{
-// 			name: "String with 2 Triggers",
-// 			request: p.CheckRequest{
-// 				Urn:  urn("StatefulString"),
-// 				Olds: resource.PropertyMap{},
-// 				News: resource.PropertyMap{
-// 					"string": resource.NewStringProperty("1"),
-// 					"triggers": resource.NewObjectProperty(resource.PropertyMap{
-// 						"foo":  resource.NewStringProperty("barX"),
-// 						"foo3": resource.NewStringProperty("bar3"),
-// 					}),
-// 				},
-// 			},
-// 			expectedResult: p.CheckResponse{
-// 				Inputs: resource.PropertyMap{
-// 					"string": resource.NewStringProperty("1"),
-// 					"triggers": resource.NewObjectProperty(resource.PropertyMap{
-// 						"foo":  resource.NewStringProperty("barX"),
-// 						"foo3": resource.NewStringProperty("bar3"),
-// 					}),
-// 				},
-// 				Failures: nil,
-// 			},
-// 		},
-// 		{
-// 			name: "No String with 2 Triggers",
-// 			request: p.CheckRequest{
-// 				Urn:  urn("StatefulString"),
-// 				Olds: resource.PropertyMap{},
-// 				News: resource.PropertyMap{
-// 					"string":   resource.NewStringProperty("1"),
-// 					"triggers": resource.NewObjectProperty(resource.PropertyMap{}),
-// 				},
-// 			},
-// 			expectedResult: p.CheckResponse{
-// 				Inputs: resource.PropertyMap{
-// 					"string": resource.NewStringProperty(""),
-// 				},
-// 				Failures: nil,
-// 			},
-// 		},
-// {
-// 	name: "String with 0 Triggers",
-// 	request: p.CheckRequest{
-// 		Urn:  urn("StatefulString"),
-// 		Olds: resource.PropertyMap{},
-// 		News: resource.PropertyMap{
-// 			"string": resource.NewStringProperty("1"),
-// 			"triggers": resource.NewObjectProperty(resource.PropertyMap{
-// 				"foo":  resource.NewStringProperty("barX"),
-// 				"foo3": resource.NewStringProperty("bar3"),
-// 			}),
-// 		},
-// 	},
-// 	expectedResult: p.CheckResponse{
-// 		Inputs: resource.PropertyMap{
-// 			"property1": resource.NewStringProperty("value1"),
-// 		},
-// 		Failures: nil,
-// 	},
-// },
-// {
-// 	name: "No String with 0 Triggers",
-// 	request: p.CheckRequest{
-// 		Urn:  urn("StatefulString"),
-// 		Olds: resource.PropertyMap{},
-// 		News: resource.PropertyMap{
-// 			"string":   resource.NewStringProperty("1"),
-// 			"triggers": resource.NewObjectProperty(resource.PropertyMap{}),
-// 		},
-// 	},
-// 	expectedResult: p.CheckResponse{
-// 		Inputs: resource.PropertyMap{
-// 			"property1": resource.NewStringProperty("value1"),
-// 		},
-// 		Failures: nil,
-// 	},
-// },
-// {
-// 	name: "String with missing Triggers",
-// 	request: p.CheckRequest{
-// 		Urn:  urn("StatefulString"),
-// 		Olds: resource.PropertyMap{},
-// 		News: resource.PropertyMap{
-// 			"string": resource.NewStringProperty("1"),
-// 		},
-// 	},
-// 	expectedResult: p.CheckResponse{
-// 		Inputs: resource.PropertyMap{
-// 			"property1": resource.NewStringProperty("value1"),
-// 		},
-// 		Failures: nil,
-// 	},
-// },
-// {
-// 	name: "No String with missing Triggers",
-// 	request: p.CheckRequest{
-// 		Urn:  urn("StatefulString"),
-// 		Olds: resource.PropertyMap{},
-// 		News: resource.PropertyMap{
-// 			"string": resource.NewStringProperty("1"),
-// 		},
-// 	},
-// 	expectedResult: p.CheckResponse{
-// 		Inputs: resource.PropertyMap{
-// 			"property1": resource.NewStringProperty("value1"),
-// 		},
-// 		Failures: nil,
-// 	},
-// },
-// {
-// 	name: "Missing String with Missing Triggers",
-// 	request: p.CheckRequest{
-// 		Urn:  urn("StatefulString"),
-// 		Olds: resource.PropertyMap{},
-// 		News: resource.PropertyMap{},
-// 	},
-// 	expectedResult: p.CheckResponse{
-// 		Inputs: resource.PropertyMap{
-// 			"property1": resource.NewStringProperty("value1"),
-// 		},
-// 		Failures: nil,
-// 	},
-// },
-// {
-// 	name: "Missing String with 0 Triggers",
-// 	request: p.CheckRequest{
-// 		Urn:  urn("StatefulString"),
-// 		Olds: resource.PropertyMap{},
-// 		News: resource.PropertyMap{
-// 			"triggers": resource.NewObjectProperty(resource.PropertyMap{}),
-// 		},
-// 	},
-// 	expectedResult: p.CheckResponse{
-// 		Inputs: resource.PropertyMap{
-// 			"property1": resource.NewStringProperty("value1"),
-// 		},
-// 		Failures: nil,
-// 	},
-// },
-// {
-// 	name: "Missing String with 1 Triggers",
-// 	request: p.CheckRequest{
-// 		Urn:  urn("StatefulString"),
-// 		Olds: resource.PropertyMap{},
-// 		News: resource.PropertyMap{
-// 			"triggers": resource.NewObjectProperty(resource.PropertyMap{
-// 				"foo": resource.NewStringProperty("bar"),
-// 			}),
-// 		},
-// 	},
-// 	expectedResult: p.CheckResponse{
-// 		Inputs: resource.PropertyMap{
-// 			"property1": resource.NewStringProperty("value1"),
-// 		},
-// 		Failures: nil,
-// 	},
-// },
-// }
-
-// 	for _, tc := range testCases {
-// 		t.Run(tc.name, func(t *testing.T) {
-// 			// Call the Check function
-// 			response, err := prov.Check(tc.request)
-// 			require.NoError(t, err)
-
-// 			// Check the result
-// 			assert.Equal(t, tc.expectedResult, response)
-// 		})
-// 	}
-// }
+func TestTransforms(t *testing.T) {
+	prov := provider()
+
+	lower := resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewObjectProperty(resource.PropertyMap{
+			"kind": resource.NewStringProperty("lower"),
+		}),
+	})
+	base64 := resource.NewArrayProperty([]resource.PropertyValue{
+		resource.NewObjectProperty(resource.PropertyMap{
+			"kind": resource.NewStringProperty("base64"),
+		}),
+	})
+	triggers := resource.NewObjectProperty(resource.PropertyMap{
+		"foo": resource.NewStringProperty("bar"),
+	})
+
+	olds := resource.PropertyMap{
+		"string":     resource.NewStringProperty("Hello"),
+		"triggers":   triggers,
+		"transforms": lower,
+		"result":     resource.NewStringProperty("hello"),
+	}
+
+	tests := []struct {
+		name           string
+		updatedProps   resource.PropertyMap
+		expectedDiff   ExpectedDiffResult
+		expectedResult string
+	}{
+		{
+			name: "Transforms Same__Triggers Same",
+			updatedProps: resource.PropertyMap{
+				"string":     resource.NewStringProperty("Hello"),
+				"triggers":   triggers,
+				"transforms": lower,
+			},
+			expectedDiff: ExpectedDiffResult{
+				HasChanges:   false,
+				DetailedDiff: map[string]p.PropertyDiff{},
+			},
+			expectedResult: "hello",
+		},
+		{
+			name: "Transforms Change__Triggers Same",
+			updatedProps: resource.PropertyMap{
+				"string":     resource.NewStringProperty("Hello"),
+				"triggers":   triggers,
+				"transforms": base64,
+			},
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"transforms": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedResult: "SGVsbG8=",
+		},
+		{
+			name: "Transforms Removed__Triggers Same",
+			updatedProps: resource.PropertyMap{
+				"string":   resource.NewStringProperty("Hello"),
+				"triggers": triggers,
+			},
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"transforms": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedResult: "Hello",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDiff, err := prov.Diff(p.DiffRequest{
+				Urn:  urn("StatefulString"),
+				Olds: olds,
+				News: tt.updatedProps,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDiff.HasChanges, gotDiff.HasChanges)
+			assert.Equal(t, tt.expectedDiff.DetailedDiff, gotDiff.DetailedDiff)
+
+			updateResponse, err := prov.Update(p.UpdateRequest{
+				Urn:  urn("StatefulString"),
+				Olds: olds,
+				News: tt.updatedProps,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "Hello", updateResponse.Properties["string"].StringValue())
+			assert.Equal(t, tt.expectedResult, updateResponse.Properties["result"].StringValue())
+		})
+	}
+}
+
+func TestStaleString(t *testing.T) {
+	prov := provider()
+
+	triggers := resource.NewObjectProperty(resource.PropertyMap{
+		"foo": resource.NewStringProperty("bar"),
+	})
+	created, err := prov.Create(p.CreateRequest{
+		Urn: urn("StatefulString"),
+		Properties: resource.PropertyMap{
+			"string":   resource.NewStringProperty("1"),
+			"triggers": triggers,
+		},
+	})
+	require.NoError(t, err)
+
+	// Editing only the string records it as desired but keeps the pinned value
+	edited := resource.PropertyMap{
+		"string":   resource.NewStringProperty("2"),
+		"triggers": triggers,
+	}
+	stale, err := prov.Update(p.UpdateRequest{
+		Urn:  urn("StatefulString"),
+		Olds: created.Properties,
+		News: edited,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1", stale.Properties["string"].StringValue())
+	assert.Equal(t, "2", stale.Properties["desiredString"].StringValue())
+	assert.True(t, stale.Properties["isStale"].BoolValue())
+
+	// Once the desired string is recorded there is nothing left to update
+	diff, err := prov.Diff(p.DiffRequest{
+		Urn:  urn("StatefulString"),
+		Olds: stale.Properties,
+		News: edited,
+	})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges)
+
+	// A trigger change pins the desired string
+	rotated, err := prov.Update(p.UpdateRequest{
+		Urn:  urn("StatefulString"),
+		Olds: stale.Properties,
+		News: resource.PropertyMap{
+			"string": resource.NewStringProperty("2"),
+			"triggers": resource.NewObjectProperty(resource.PropertyMap{
+				"foo": resource.NewStringProperty("bar2"),
+			}),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2", rotated.Properties["string"].StringValue())
+	assert.Equal(t, "2", rotated.Properties["desiredString"].StringValue())
+	assert.False(t, rotated.Properties["isStale"].BoolValue())
+}
+
+func TestRotationMode(t *testing.T) {
+	prov := provider()
+
+	tests := []struct {
+		name           string
+		initialProps   resource.PropertyMap
+		updatedProps   resource.PropertyMap
+		expectedDiff   ExpectedDiffResult
+		expectedString string
+	}{
+		{
+			name:         "String Change__rotateOnStringChange",
+			initialProps: inputs("bar", fields{"rotationMode": "rotateOnStringChange", "string": "1"}),
+			updatedProps: inputs("bar", fields{"rotationMode": "rotateOnStringChange", "string": "2"}),
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"string": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedString: "2",
+		},
+		{
+			name:         "Triggers Change__rotateOnStringChange",
+			initialProps: inputs("bar", fields{"rotationMode": "rotateOnStringChange", "string": "1"}),
+			updatedProps: inputs("bar2", fields{"rotationMode": "rotateOnStringChange", "string": "2"}),
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"string": {
+						Kind: p.DiffKind("update"),
+					},
+					"triggers.foo": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedString: "2",
+		},
+		{
+			name:         "Nonce Change__rotateOnTriggerChange",
+			initialProps: inputs("bar", fields{"rotationMode": "rotateOnTriggerChange", "string": "1", "forceRotate": "a"}),
+			updatedProps: inputs("bar", fields{"rotationMode": "rotateOnTriggerChange", "string": "2", "forceRotate": "b"}),
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"forceRotate": {
+						Kind: p.DiffKind("update"),
+					},
+					"string": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedString: "2",
+		},
+		{
+			name:         "Nonce Added__rotateOnTriggerChange",
+			initialProps: inputs("bar", fields{"rotationMode": "rotateOnTriggerChange", "string": "1"}),
+			updatedProps: inputs("bar", fields{"rotationMode": "rotateOnTriggerChange", "string": "1", "forceRotate": "a"}),
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"forceRotate": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedString: "1",
+		},
+		{
+			name:         "Triggers Change__explicit",
+			initialProps: inputs("bar", fields{"rotationMode": "explicit", "string": "1", "forceRotate": "a"}),
+			updatedProps: inputs("bar2", fields{"rotationMode": "explicit", "string": "2", "forceRotate": "a"}),
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"desiredString": {
+						Kind: p.DiffKind("update"),
+					},
+					"triggers.foo": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedString: "1",
+		},
+		{
+			name:         "Nonce Change__explicit",
+			initialProps: inputs("bar", fields{"rotationMode": "explicit", "string": "1", "forceRotate": "a"}),
+			updatedProps: inputs("bar", fields{"rotationMode": "explicit", "string": "2", "forceRotate": "b"}),
+			expectedDiff: ExpectedDiffResult{
+				HasChanges: true,
+				DetailedDiff: map[string]p.PropertyDiff{
+					"forceRotate": {
+						Kind: p.DiffKind("update"),
+					},
+					"string": {
+						Kind: p.DiffKind("update"),
+					},
+				},
+			},
+			expectedString: "2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotDiff, err := prov.Diff(p.DiffRequest{
+				Urn:  urn("StatefulString"),
+				Olds: tt.initialProps,
+				News: tt.updatedProps,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDiff.HasChanges, gotDiff.HasChanges)
+			assert.Equal(t, tt.expectedDiff.DetailedDiff, gotDiff.DetailedDiff)
+
+			updateResponse, err := prov.Update(p.UpdateRequest{
+				Urn:  urn("StatefulString"),
+				Olds: tt.initialProps,
+				News: tt.updatedProps,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedString, updateResponse.Properties["string"].StringValue())
+		})
+	}
+}
+
+func TestExpiry(t *testing.T) {
+	prov := provider()
+
+	triggers := resource.NewObjectProperty(resource.PropertyMap{
+		"foo": resource.NewStringProperty("bar"),
+	})
+
+	created, err := prov.Create(p.CreateRequest{
+		Urn: urn("StatefulString"),
+		Properties: resource.PropertyMap{
+			"string":       resource.NewStringProperty("token"),
+			"triggers":     triggers,
+			"expiresAfter": resource.NewStringProperty("1h"),
+		},
+	})
+	require.NoError(t, err)
+	expiresAt, err := time.Parse(time.RFC3339, created.Properties["expiresAt"].StringValue())
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	expired := func(policy string) (olds, news resource.PropertyMap) {
+		olds = resource.PropertyMap{
+			"string":       resource.NewStringProperty("token"),
+			"triggers":     triggers,
+			"expiresAfter": resource.NewStringProperty("1h"),
+			"expiresAt":    resource.NewStringProperty("2000-01-01T00:00:00Z"),
+		}
+		news = resource.PropertyMap{
+			"string":       resource.NewStringProperty("token2"),
+			"triggers":     triggers,
+			"expiresAfter": resource.NewStringProperty("1h"),
+			"onExpiry":     resource.NewStringProperty(policy),
+		}
+		olds["onExpiry"] = news["onExpiry"]
+		olds["desiredString"] = news["string"]
+		return olds, news
+	}
+
+	t.Run("warn", func(t *testing.T) {
+		olds, news := expired("warn")
+		diff, err := prov.Diff(p.DiffRequest{Urn: urn("StatefulString"), Olds: olds, News: news})
+		require.NoError(t, err)
+		assert.False(t, diff.HasChanges)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		olds, news := expired("fail")
+		_, err := prov.Diff(p.DiffRequest{Urn: urn("StatefulString"), Olds: olds, News: news})
+		assert.ErrorContains(t, err, "the pinned string expired at 2000-01-01T00:00:00Z")
+
+		// Rotating the string is the way out
+		news["forceRotate"] = resource.NewStringProperty("1")
+		_, err = prov.Diff(p.DiffRequest{Urn: urn("StatefulString"), Olds: olds, News: news})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		olds, news := expired("rotate")
+		diff, err := prov.Diff(p.DiffRequest{Urn: urn("StatefulString"), Olds: olds, News: news})
+		require.NoError(t, err)
+		assert.True(t, diff.HasChanges)
+		assert.Equal(t, map[string]p.PropertyDiff{
+			"expiresAt": {Kind: p.DiffKind("update")},
+			"string":    {Kind: p.DiffKind("update")},
+		}, diff.DetailedDiff)
+
+		updated, err := prov.Update(p.UpdateRequest{Urn: urn("StatefulString"), Olds: olds, News: news})
+		require.NoError(t, err)
+		assert.Equal(t, "token2", updated.Properties["string"].StringValue())
+		expiresAt, err := time.Parse(time.RFC3339, updated.Properties["expiresAt"].StringValue())
+		require.NoError(t, err)
+		assert.True(t, expiresAt.After(time.Now()))
+	})
+
+	t.Run("rotate holds the constraints", func(t *testing.T) {
+		olds, news := expired("rotate")
+		news["maxLength"] = resource.NewNumberProperty(5)
+		_, err := prov.Update(p.UpdateRequest{Urn: urn("StatefulString"), Olds: olds, News: news})
+		assert.ErrorContains(t, err, "string is invalid: value is 6 characters long, longer than maxLength 5")
+	})
+
+	t.Run("check", func(t *testing.T) {
+		response, err := prov.Check(p.CheckRequest{
+			Urn: urn("StatefulString"),
+			News: resource.PropertyMap{
+				"string":     resource.NewStringProperty("token"),
+				"triggers":   triggers,
+				"warnBefore": resource.NewStringProperty("soon"),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []p.CheckFailure{
+			{Property: "warnBefore", Reason: `time: invalid duration "soon"`},
+			{Property: "warnBefore", Reason: "warnBefore requires expiresAfter"},
+		}, response.Failures)
+	})
+}
+
+func TestPreviousValue(t *testing.T) {
+	prov := provider()
+
+	retained := fields{"previousRetention": "1h"}
+
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("1", retained, fields{"string": "v1"}),
+	})
+	require.NoError(t, err)
+	// A literal string is only as secret as the program made it
+	assert.False(t, created.Properties["current"].IsSecret())
+	assert.Equal(t, "v1", created.Properties["current"].StringValue())
+	assert.False(t, created.Properties.HasValue("previous"))
+
+	// A rotation keeps the replaced string for the retention period
+	rotated, err := prov.Update(p.UpdateRequest{
+		Urn:  urn("StatefulString"),
+		Olds: created.Properties,
+		News: inputs("2", retained, fields{"string": "v2"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "v2", rotated.Properties["current"].StringValue())
+	assert.Equal(t, "v1", rotated.Properties["previous"].StringValue())
+	retainedUntil, err := time.Parse(time.RFC3339, rotated.Properties["previousRetainedUntil"].StringValue())
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), retainedUntil, time.Minute)
+
+	// Updates that do not rotate leave it alone
+	diff, err := prov.Diff(p.DiffRequest{
+		Urn:  urn("StatefulString"),
+		Olds: rotated.Properties,
+		News: inputs("2", retained, fields{"string": "v2"}),
+	})
+	require.NoError(t, err)
+	assert.False(t, diff.HasChanges)
+
+	// Once the retention period is over it is dropped
+	lapsed := rotated.Properties.Copy()
+	lapsed["previousRetainedUntil"] = resource.NewStringProperty("2000-01-01T00:00:00Z")
+	diff, err = prov.Diff(p.DiffRequest{
+		Urn:  urn("StatefulString"),
+		Olds: lapsed,
+		News: inputs("2", retained, fields{"string": "v2"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"previous": {Kind: p.DiffKind("delete")},
+	}, diff.DetailedDiff)
+
+	updated, err := prov.Update(p.UpdateRequest{
+		Urn:  urn("StatefulString"),
+		Olds: lapsed,
+		News: inputs("2", retained, fields{"string": "v2"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "v2", updated.Properties["current"].StringValue())
+	assert.False(t, updated.Properties.HasValue("previous"))
+	assert.False(t, updated.Properties.HasValue("previousRetainedUntil"))
+}
+
+func TestStagedRotation(t *testing.T) {
+	prov := provider()
+
+	staged := fields{"staged": true}
+	absent := resource.NewNullProperty()
+	promote := fields{"promote": "1"}
+	after := fields{"promoteAfterUpdates": 2}
+
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("1", staged, fields{"string": "v1"}),
+	})
+	require.NoError(t, err)
+
+	// Each step is applied on top of the state left by the one before it
+	type step struct {
+		news         resource.PropertyMap
+		expectedDiff map[string]p.PropertyDiff
+		// The outputs to compare, where a null value must be absent
+		expectedOutputs resource.PropertyMap
+	}
+	testCases := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "promote nonce",
+			steps: []step{
+				{
+					// The rotation only stages the new string
+					news: inputs("2", staged, fields{"string": "v2"}),
+					expectedDiff: map[string]p.PropertyDiff{
+						"triggers.foo": {Kind: p.DiffKind("update")},
+						"pending":      {Kind: p.DiffKind("add")},
+					},
+					expectedOutputs: resource.PropertyMap{
+						"string":  resource.NewStringProperty("v1"),
+						"pending": resource.NewStringProperty("v2"),
+						"isStale": resource.NewBoolProperty(false),
+					},
+				},
+				{
+					// Promoting pins it
+					news: inputs("2", staged, fields{"string": "v2"}, promote),
+					expectedDiff: map[string]p.PropertyDiff{
+						"promote": {Kind: p.DiffKind("update")},
+						"pending": {Kind: p.DiffKind("delete")},
+						"string":  {Kind: p.DiffKind("update")},
+					},
+					expectedOutputs: resource.PropertyMap{
+						"string":   resource.NewStringProperty("v2"),
+						"previous": resource.NewStringProperty("v1"),
+						"pending":  absent,
+					},
+				},
+			},
+		},
+		{
+			name: "after updates",
+			steps: []step{
+				{
+					news: inputs("2", staged, fields{"string": "v2"}, after),
+					expectedDiff: map[string]p.PropertyDiff{
+						"triggers.foo":        {Kind: p.DiffKind("update")},
+						"pending":             {Kind: p.DiffKind("add")},
+						"promoteAfterUpdates": {Kind: p.DiffKind("update")},
+					},
+					expectedOutputs: resource.PropertyMap{
+						"pendingUpdates": resource.NewNumberProperty(0),
+					},
+				},
+				{
+					// The first later update only counts down
+					news: inputs("2", staged, fields{"string": "v2"}, after),
+					expectedDiff: map[string]p.PropertyDiff{
+						"pendingUpdates": {Kind: p.DiffKind("update")},
+					},
+					expectedOutputs: resource.PropertyMap{
+						"string":         resource.NewStringProperty("v1"),
+						"pendingUpdates": resource.NewNumberProperty(1),
+					},
+				},
+				{
+					// The second promotes
+					news: inputs("2", staged, fields{"string": "v2"}, after),
+					expectedDiff: map[string]p.PropertyDiff{
+						"pending": {Kind: p.DiffKind("delete")},
+						"string":  {Kind: p.DiffKind("update")},
+					},
+					expectedOutputs: resource.PropertyMap{
+						"string":         resource.NewStringProperty("v2"),
+						"pending":        absent,
+						"pendingUpdates": absent,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			olds := created.Properties
+			for _, step := range tc.steps {
+				diff, err := prov.Diff(p.DiffRequest{Urn: urn("StatefulString"), Olds: olds, News: step.news})
+				require.NoError(t, err)
+				assert.Equal(t, step.expectedDiff, diff.DetailedDiff)
+
+				updated, err := prov.Update(p.UpdateRequest{Urn: urn("StatefulString"), Olds: olds, News: step.news})
+				require.NoError(t, err)
+				for key, expected := range step.expectedOutputs {
+					if expected.IsNull() {
+						assert.False(t, updated.Properties.HasValue(key), "%s must be absent", key)
+					} else {
+						assert.Equal(t, expected, updated.Properties[key], key)
+					}
+				}
+				olds = updated.Properties
+			}
+		})
+	}
+}
+
+func TestStructuredTriggers(t *testing.T) {
+	prov := provider()
+
+	// Triggers made of a bool and an object holding a number and a list
+	structured := func(replicas float64, tags ...string) fields {
+		return fields{"string": "value", "triggers": fields{
+			"enabled": true,
+			"config":  fields{"replicas": replicas, "tags": tags},
+		}}
+	}
+
+	created, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("", structured(3, "a", "b")),
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		news     resource.PropertyMap
+		expected map[string]p.PropertyDiff
+	}{
+		{
+			name:     "equal values",
+			news:     inputs("", structured(3.0, "a", "b")),
+			expected: map[string]p.PropertyDiff{},
+		},
+		{
+			name: "nested number",
+			news: inputs("", structured(4, "a", "b")),
+			expected: map[string]p.PropertyDiff{
+				"triggers.config.replicas": {Kind: p.DiffKind("update")},
+			},
+		},
+		{
+			name: "list elements",
+			news: inputs("", structured(3, "a", "c", "d")),
+			expected: map[string]p.PropertyDiff{
+				"triggers.config.tags[1]": {Kind: p.DiffKind("update")},
+				"triggers.config.tags[2]": {Kind: p.DiffKind("add")},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diff, err := prov.Diff(p.DiffRequest{
+				Urn:  urn("StatefulString"),
+				Olds: created.Properties,
+				News: tc.news,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, diff.DetailedDiff)
+			assert.Equal(t, len(tc.expected) > 0, diff.HasChanges)
+		})
+	}
+}
+
+func TestTriggerExplanation(t *testing.T) {
+	prov := provider()
+
+	olds := resource.PropertyMap{
+		"string": resource.NewStringProperty("value"),
+		"triggers": resource.NewObjectProperty(resource.PropertyMap{
+			"image":   resource.NewStringProperty("app:1.0"),
+			"apiKey":  resource.NewStringProperty("key-aaaa1111"),
+			"retired": resource.NewStringProperty("x"),
+		}),
+		"sensitiveTriggers": resource.NewArrayProperty([]resource.PropertyValue{
+			resource.NewStringProperty("apiKey"),
+		}),
+	}
+	news := olds.Copy()
+	news["triggers"] = resource.NewObjectProperty(resource.PropertyMap{
+		"image":  resource.NewStringProperty("app:1.1"),
+		"apiKey": resource.NewStringProperty("key-bbbb2222"),
+		"region": resource.NewStringProperty("eu"),
+	})
+
+	logs := captureLogs(t, func() {
+		_, err := prov.Diff(p.DiffRequest{Urn: urn("StatefulString"), Olds: olds, News: news})
+		require.NoError(t, err)
+	})
+	assert.Contains(t, logs, "triggers changed:\n"+
+		"triggers.apiKey changed: ****1111 -> ****2222\n"+
+		`triggers.image changed: "app:1.0" -> "app:1.1"`+"\n"+
+		`triggers.region added: "eu"`+"\n"+
+		`triggers.retired removed: was "x"`)
+	assert.NotContains(t, logs, "key-bbbb2222")
+
+	// Secret trigger values are hidden without being named in sensitiveTriggers
+	secretOlds := resource.PropertyMap{
+		"string": resource.NewStringProperty("value"),
+		"triggers": resource.NewObjectProperty(resource.PropertyMap{
+			"token": resource.MakeSecret(resource.NewStringProperty("tok-aaaa1111")),
+			"db": resource.NewObjectProperty(resource.PropertyMap{
+				"host":     resource.NewStringProperty("db-1"),
+				"password": resource.MakeSecret(resource.NewStringProperty("pw-aaaa1111")),
+			}),
+		}),
+	}
+	secretNews := secretOlds.Copy()
+	secretNews["triggers"] = resource.NewObjectProperty(resource.PropertyMap{
+		"token": resource.MakeSecret(resource.NewStringProperty("tok-bbbb2222")),
+		"db": resource.NewObjectProperty(resource.PropertyMap{
+			"host":     resource.NewStringProperty("db-2"),
+			"password": resource.MakeSecret(resource.NewStringProperty("pw-bbbb2222")),
+		}),
+	})
+	logs = captureLogs(t, func() {
+		_, err := prov.Diff(p.DiffRequest{Urn: urn("StatefulString"), Olds: secretOlds, News: secretNews})
+		require.NoError(t, err)
+	})
+	assert.Contains(t, logs, "triggers changed:\n"+
+		`triggers.db.host changed: "db-1" -> "db-2"`+"\n"+
+		"triggers.db.password changed: [secret] -> [secret]\n"+
+		"triggers.token changed: [secret] -> [secret]")
+	assert.NotContains(t, logs, "1111")
+	assert.NotContains(t, logs, "2222")
+}
+
+func TestCheck(t *testing.T) {
+	prov := provider()
+
+	testCases := []struct {
+		name             string
+		olds             resource.PropertyMap
+		news             resource.PropertyMap
+		expectedFailures []p.CheckFailure
+	}{
+		{
+			name: "No constraints",
+			news: inputs("bar", fields{"string": "Anything Goes"}),
+		},
+		{
+			name: "No triggers",
+			news: inputs("", fields{"string": "constant"}, fields{"triggers": nil}),
+		},
+		{
+			name: "All constraints satisfied",
+			news: inputs("bar", fields{"string": "my-bucket-01"}, fields{
+				"pattern":       "^[a-z]",
+				"minLength":     3,
+				"maxLength":     63,
+				"charset":       "a-z0-9-",
+				"allowedValues": []string{"my-bucket-01"},
+			}),
+		},
+		{
+			name: "Pattern mismatch",
+			news: inputs("bar", fields{"string": "My_Bucket"}, fields{"pattern": "^[a-z]"}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "string", Reason: `value does not match pattern "^[a-z]"`},
+			},
+		},
+		{
+			name: "Length and charset violations",
+			news: inputs("bar", fields{"string": "AB"}, fields{"minLength": 3, "charset": "a-z"}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "string", Reason: "value is 2 characters long, shorter than minLength 3"},
+				{Property: "string", Reason: "value contains characters outside of charset [a-z]"},
+			},
+		},
+		{
+			name: "Value not allowed",
+			news: inputs("bar", fields{"string": "staging"}, fields{"allowedValues": []string{"dev", "prod"}}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "string", Reason: "value is not one of the allowed values: dev, prod"},
+			},
+		},
+		{
+			name: "Invalid constraints",
+			news: inputs("bar", fields{"string": "abc"}, fields{"pattern": "(", "minLength": 5, "maxLength": 4}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "pattern", Reason: "invalid regular expression: error parsing regexp: missing closing ): `(`"},
+				{Property: "minLength", Reason: "minLength (5) is greater than maxLength (4)"},
+			},
+		},
+		{
+			name: "Invalid transform",
+			news: inputs("bar", fields{"string": "abc"}, fields{"transforms": []fields{{"kind": "prefix"}}}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "transforms", Reason: "transforms[0]: prefix requires a value"},
+			},
+		},
+		{
+			name: "JSON schema satisfied",
+			news: inputs("bar", fields{"string": `{"replicas": 3}`}, fields{
+				"jsonSchema": `{"type": "object", "required": ["replicas"]}`,
+			}),
+		},
+		{
+			name: "JSON schema violations",
+			news: inputs("bar", fields{"string": `{"spec": {"replicas": "3", "ports": [80, -1]}}`}, fields{
+				"jsonSchema": `{
+					"type": "object",
+					"properties": {"spec": {"properties": {
+						"replicas": {"type": "integer"},
+						"ports": {"items": {"minimum": 0}}
+					}}}
+				}`,
+			}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "string", Reason: "$.spec.ports[1]: must be >= 0 but found -1"},
+				{Property: "string", Reason: "$.spec.replicas: expected integer, but got string"},
+			},
+		},
+		{
+			name: "JSON schema with invalid document",
+			news: inputs("bar", fields{"string": `{"replicas": `}, fields{"jsonSchema": `{"type": "object"}`}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "string", Reason: "value is not valid JSON: unexpected end of JSON input"},
+			},
+		},
+		{
+			name: "Triggers Same__value not pinned",
+			olds: inputs("bar", fields{"string": "ok"}),
+			news: inputs("bar", fields{"string": "NOT OK"}, fields{"pattern": "^[a-z]+$"}),
+		},
+		{
+			name: "Triggers Change__value pinned",
+			olds: inputs("bar", fields{"string": "ok"}),
+			news: inputs("bar2", fields{"string": "NOT OK"}, fields{"pattern": "^[a-z]+$"}),
+			expectedFailures: []p.CheckFailure{
+				{Property: "string", Reason: `value does not match pattern "^[a-z]+$"`},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := prov.Check(p.CheckRequest{
+				Urn:  urn("StatefulString"),
+				Olds: tc.olds,
+				News: tc.news,
+			})
+			require.NoError(t, err)
+
+			// Inputs are handed back untouched and failures point at the offending property
+			assert.Equal(t, tc.news, response.Inputs)
+			if len(tc.expectedFailures) == 0 {
+				assert.Empty(t, response.Failures)
+			} else {
+				assert.Equal(t, tc.expectedFailures, response.Failures)
+			}
+		})
+	}
+}
+
+// The SDKs send schema defaults as inputs, which an existing state lacks, so inputs that
+// already act on their default when unset must not declare one: every update would report
+// them as changed.
+func TestNoSchemaDefaults(t *testing.T) {
+	resp, err := provider().GetSchema(p.GetSchemaRequest{})
+	require.NoError(t, err)
+	var schema struct {
+		Resources map[string]struct {
+			InputProperties map[string]map[string]any `json:"inputProperties"`
+		} `json:"resources"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(resp.Schema), &schema))
+
+	properties := schema.Resources[statefulString.Name+":index:StatefulString"].InputProperties
+	require.NotEmpty(t, properties)
+	for key, property := range properties {
+		assert.NotContains(t, property, "default", key)
+	}
+}
 
 // urn is a helper function to build an urn for running integration tests.
 func urn(typ string) resource.URN {
@@ -753,6 +1540,62 @@ func urn(typ string) resource.URN {
 		tokens.Type("test:index:"+typ), "name")
 }
 
+// fields are the inputs of a resource as plain Go values, as resource.NewPropertyValue
+// takes them, or as property values and maps.
+type fields = map[string]any
+
+// inputs builds the inputs of a resource from sets of fields, where later sets win. The
+// inputs have a single trigger, foo, set to trigger; a nil field leaves the input out, so
+// fields can also drop or replace the triggers.
+func inputs(trigger string, sets ...fields) resource.PropertyMap {
+	m := resource.PropertyMap{
+		"triggers": resource.NewObjectProperty(resource.PropertyMap{
+			"foo": resource.NewStringProperty(trigger),
+		}),
+	}
+	for _, set := range sets {
+		for k, v := range set {
+			if v == nil {
+				delete(m, resource.PropertyKey(k))
+				continue
+			}
+			m[resource.PropertyKey(k)] = resource.NewPropertyValueRepl(v, nil, func(v any) (resource.PropertyValue, bool) {
+				switch v := v.(type) {
+				case resource.PropertyValue:
+					return v, true
+				case resource.PropertyMap:
+					return resource.NewObjectProperty(v), true
+				}
+				return resource.PropertyValue{}, false
+			})
+		}
+	}
+	return m
+}
+
+// secretString returns the string held by the secret property key of props, failing the
+// test when the property is not secret.
+func secretString(t *testing.T, props resource.PropertyMap, key resource.PropertyKey) string {
+	v := props[key]
+	require.True(t, v.IsSecret(), "%s must be secret", key)
+	return v.SecretValue().Element.StringValue()
+}
+
+// captureLogs returns everything the test server logs while fn runs.
+func captureLogs(t *testing.T, fn func()) string {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	fn()
+	require.NoError(t, w.Close())
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return string(out)
+}
+
 // Create a test server.
 func provider() integration.Server {
 	return integration.NewServer(statefulString.Name, semver.MustParse("1.0.0"), statefulString.Provider())
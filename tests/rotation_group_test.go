@@ -0,0 +1,147 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationGroup(t *testing.T) {
+	manifest := filepath.Join(t.TempDir(), "rotation.json")
+	writeManifest := func(content string) {
+		require.NoError(t, os.WriteFile(manifest, []byte(content), 0o600))
+	}
+	writeManifest(`{"db": {"generation": 1}, "cache": "2024-01"}`)
+
+	prov := provider()
+	err := prov.Configure(p.ConfigureRequest{
+		Args: resource.PropertyMap{
+			"rotationManifest": resource.NewStringProperty(manifest),
+		},
+	})
+	require.NoError(t, err)
+
+	// Members of the group have no triggers of their own
+	member := fields{"rotationGroup": "db", "triggers": resource.PropertyMap{}}
+	groupTrigger := func() string {
+		response, err := prov.Invoke(p.InvokeRequest{
+			Token: tokens.Type("statefulString:index:getRotationGroupTrigger"),
+			Args: resource.PropertyMap{
+				"group": resource.NewStringProperty("db"),
+			},
+		})
+		require.NoError(t, err)
+		return response.Return["trigger"].StringValue()
+	}
+
+	user, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("", member, fields{"string": "user-1"}),
+	})
+	require.NoError(t, err)
+	password, err := prov.Create(p.CreateRequest{
+		Urn:        urn("StatefulString"),
+		Properties: inputs("", member, fields{"string": "password-1"}),
+	})
+	require.NoError(t, err)
+	before := groupTrigger()
+	assert.Equal(t, before, user.Properties["rotationGroupTrigger"].StringValue())
+
+	// Editing another group's entry leaves the group alone
+	writeManifest(`{"db": {"generation": 1}, "cache": "2024-02"}`)
+	diff, err := prov.Diff(p.DiffRequest{
+		Urn:  urn("StatefulString"),
+		Olds: user.Properties,
+		News: inputs("", member, fields{"string": "user-2"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"desiredString": {Kind: p.DiffKind("update")},
+	}, diff.DetailedDiff)
+
+	// Editing the group's entry rotates every member together
+	writeManifest(`{"db": {"generation": 2}, "cache": "2024-02"}`)
+	after := groupTrigger()
+	assert.NotEqual(t, before, after)
+
+	for _, tc := range []struct {
+		olds     resource.PropertyMap
+		expected string
+	}{
+		{olds: user.Properties, expected: "user-2"},
+		{olds: password.Properties, expected: "password-2"},
+	} {
+		news := inputs("", member, fields{"string": tc.expected})
+		diff, err := prov.Diff(p.DiffRequest{
+			Urn:  urn("StatefulString"),
+			Olds: tc.olds,
+			News: news,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]p.PropertyDiff{
+			"rotationGroupTrigger": {Kind: p.DiffKind("update")},
+			"string":               {Kind: p.DiffKind("update")},
+		}, diff.DetailedDiff)
+
+		updated, err := prov.Update(p.UpdateRequest{
+			Urn:  urn("StatefulString"),
+			Olds: tc.olds,
+			News: news,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, updated.Properties["string"].StringValue())
+		assert.Equal(t, after, updated.Properties["rotationGroupTrigger"].StringValue())
+	}
+
+	// The constraints still hold for a string the group rotates, which Check never saw
+	// about to be pinned
+	writeManifest(`{"db": {"generation": 3}, "cache": "2024-02"}`)
+	constrained := inputs("", member, fields{"string": "user-3", "maxLength": 4})
+	checked, err := prov.Check(p.CheckRequest{
+		Urn:  urn("StatefulString"),
+		Olds: user.Properties,
+		News: constrained,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, checked.Failures)
+	_, err = prov.Update(p.UpdateRequest{
+		Urn:  urn("StatefulString"),
+		Olds: user.Properties,
+		News: constrained,
+	})
+	assert.ErrorContains(t, err, "string is invalid: value is 6 characters long, longer than maxLength 4")
+
+	// Members must name a group that is in the manifest
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("StatefulString"),
+		News: resource.PropertyMap{
+			"string":        resource.NewStringProperty("value"),
+			"rotationGroup": resource.NewStringProperty("missing"),
+			"triggers":      resource.NewObjectProperty(resource.PropertyMap{}),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Failures, 1)
+	assert.Equal(t, "rotationGroup", response.Failures[0].Property)
+	assert.Contains(t, response.Failures[0].Reason, `rotation group "missing" is not in the rotation manifest`)
+}
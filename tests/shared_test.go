@@ -0,0 +1,126 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi-go-provider/integration"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/tokens"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedStatefulString(t *testing.T) {
+	dir := t.TempDir()
+	owner := configuredProvider(t, dir)
+	reader := configuredProvider(t, dir)
+
+	get := func(prov integration.Server) (string, error) {
+		response, err := prov.Invoke(p.InvokeRequest{
+			Token: tokens.Type("statefulString:index:getSharedStatefulString"),
+			Args: resource.PropertyMap{
+				"key": resource.NewStringProperty("cluster-token"),
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		return response.Return["string"].StringValue(), nil
+	}
+
+	created, err := owner.Create(p.CreateRequest{
+		Urn:        urn("SharedStatefulString"),
+		Properties: inputs("1", fields{"key": "cluster-token", "string": "token-1"}),
+	})
+	require.NoError(t, err)
+	// The owner keeps the shared string secret in its state
+	assert.Equal(t, "token-1", secretString(t, created.Properties, "string"))
+
+	// Another stack reads the value through the invoke...
+	value, err := get(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", value)
+
+	// ...but cannot claim the key for itself
+	_, err = reader.Create(p.CreateRequest{
+		Urn:        urn("SharedStatefulString"),
+		Properties: inputs("1", fields{"key": "cluster-token", "string": "token-x"}),
+	})
+	assert.ErrorContains(t, err, `shared string "cluster-token" is owned by another stack`)
+
+	// The owner rotates the value by changing a trigger
+	diff, err := owner.Diff(p.DiffRequest{
+		Urn:  urn("SharedStatefulString"),
+		Olds: created.Properties,
+		News: inputs("2", fields{"key": "cluster-token", "string": "token-2"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]p.PropertyDiff{
+		"string":       {Kind: p.DiffKind("update")},
+		"triggers.foo": {Kind: p.DiffKind("update")},
+	}, diff.DetailedDiff)
+
+	updated, err := owner.Update(p.UpdateRequest{
+		Urn:  urn("SharedStatefulString"),
+		Olds: created.Properties,
+		News: inputs("2", fields{"key": "cluster-token", "string": "token-2"}),
+	})
+	require.NoError(t, err)
+	value, err = get(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", value)
+
+	// Deleting the owner withdraws the record
+	err = owner.Delete(p.DeleteRequest{
+		Urn:        urn("SharedStatefulString"),
+		Properties: updated.Properties,
+	})
+	require.NoError(t, err)
+	_, err = get(reader)
+	assert.ErrorContains(t, err, `no shared string has been published under "cluster-token"`)
+}
+
+func TestSharedStatefulStringCheck(t *testing.T) {
+	prov := provider()
+
+	response, err := prov.Check(p.CheckRequest{
+		Urn: urn("SharedStatefulString"),
+		News: resource.PropertyMap{
+			"key":      resource.NewStringProperty("../escape"),
+			"string":   resource.NewStringProperty("value"),
+			"triggers": resource.NewObjectProperty(resource.PropertyMap{}),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []p.CheckFailure{{
+		Property: "key",
+		Reason:   `key "../escape" must start with a letter or digit and contain only letters, digits, '.', '_' and '-'`,
+	}}, response.Failures)
+}
+
+// configuredProvider creates a test server whose shared store lives in dir.
+func configuredProvider(t *testing.T, dir string) integration.Server {
+	prov := provider()
+	err := prov.Configure(p.ConfigureRequest{
+		Args: resource.PropertyMap{
+			"sharedStoreDir": resource.NewStringProperty(dir),
+		},
+	})
+	require.NoError(t, err)
+	return prov
+}
@@ -0,0 +1,173 @@
+// Copyright 2016-2023, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	p "github.com/pulumi/pulumi-go-provider"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSource(t *testing.T) {
+	prov := provider()
+
+	file := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(file, []byte("from-file\n"), 0o600))
+	t.Setenv("STATEFULSTRING_TEST_TOKEN", "from-env")
+
+	env := resource.PropertyMap{
+		"kind": resource.NewStringProperty("env"),
+		"name": resource.NewStringProperty("STATEFULSTRING_TEST_TOKEN"),
+	}
+
+	testCases := []struct {
+		name     string
+		source   resource.PropertyMap
+		expected string
+	}{
+		{
+			name:     "env",
+			source:   env,
+			expected: "from-env",
+		},
+		{
+			name: "file",
+			source: resource.PropertyMap{
+				"kind": resource.NewStringProperty("file"),
+				"path": resource.NewStringProperty(file),
+			},
+			expected: "from-file",
+		},
+		{
+			name: "command",
+			source: resource.PropertyMap{
+				"kind": resource.NewStringProperty("command"),
+				"command": resource.NewArrayProperty([]resource.PropertyValue{
+					resource.NewStringProperty("echo"),
+					resource.NewStringProperty("from-command"),
+				}),
+			},
+			expected: "from-command",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := prov.Create(p.CreateRequest{
+				Urn:        urn("StatefulString"),
+				Properties: inputs("bar", fields{"source": tc.source}),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, secretString(t, response.Properties, "string"))
+		})
+	}
+
+	t.Run("captured only when triggers change", func(t *testing.T) {
+		created, err := prov.Create(p.CreateRequest{
+			Urn:        urn("StatefulString"),
+			Properties: inputs("bar", fields{"source": env}),
+		})
+		require.NoError(t, err)
+		t.Setenv("STATEFULSTRING_TEST_TOKEN", "rotated")
+
+		kept, err := prov.Update(p.UpdateRequest{
+			Urn:  urn("StatefulString"),
+			Olds: created.Properties,
+			News: inputs("bar", fields{"source": env}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "from-env", secretString(t, kept.Properties, "string"))
+		assert.False(t, kept.Properties["isStale"].BoolValue())
+
+		rotated, err := prov.Update(p.UpdateRequest{
+			Urn:  urn("StatefulString"),
+			Olds: kept.Properties,
+			News: inputs("bar2", fields{"source": env}),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "rotated", secretString(t, rotated.Properties, "string"))
+	})
+
+	t.Run("refresh detects drift", func(t *testing.T) {
+		t.Setenv("STATEFULSTRING_TEST_TOKEN", "from-env")
+		news := inputs("bar", fields{"source": env})
+		news["rotateOnDrift"] = resource.NewBoolProperty(true)
+		created, err := prov.Create(p.CreateRequest{
+			Urn:        urn("StatefulString"),
+			Properties: news,
+		})
+		require.NoError(t, err)
+		assert.False(t, created.Properties["sourceDrift"].BoolValue())
+
+		t.Setenv("STATEFULSTRING_TEST_TOKEN", "changed-out-of-band")
+		read, err := prov.Read(p.ReadRequest{
+			ID:         "name",
+			Urn:        urn("StatefulString"),
+			Properties: created.Properties,
+			Inputs:     news,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "from-env", secretString(t, read.Properties, "string"))
+		assert.Equal(t, "changed-out-of-band", secretString(t, read.Properties, "currentSourceValue"))
+		assert.True(t, read.Properties["sourceDrift"].BoolValue())
+
+		// With rotateOnDrift the next update pins the drifted value
+		diff, err := prov.Diff(p.DiffRequest{
+			Urn:  urn("StatefulString"),
+			Olds: read.Properties,
+			News: news,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]p.PropertyDiff{
+			"sourceDrift": {Kind: p.DiffKind("update")},
+			"string":      {Kind: p.DiffKind("update")},
+		}, diff.DetailedDiff)
+
+		updated, err := prov.Update(p.UpdateRequest{
+			Urn:  urn("StatefulString"),
+			Olds: read.Properties,
+			News: news,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "changed-out-of-band", secretString(t, updated.Properties, "string"))
+		assert.False(t, updated.Properties["sourceDrift"].BoolValue())
+	})
+
+	t.Run("constraints apply to the captured value", func(t *testing.T) {
+		props := inputs("bar", fields{"source": env, "maxLength": 3})
+		_, err := prov.Create(p.CreateRequest{
+			Urn:        urn("StatefulString"),
+			Properties: props,
+		})
+		assert.ErrorContains(t, err, "value read from source is invalid: value is 8 characters long, longer than maxLength 3")
+	})
+
+	t.Run("check", func(t *testing.T) {
+		props := inputs("bar", fields{"source": fields{"kind": "file"}})
+		response, err := prov.Check(p.CheckRequest{Urn: urn("StatefulString"), News: props})
+		require.NoError(t, err)
+		assert.Equal(t, []p.CheckFailure{{Property: "source", Reason: "a file source requires a path"}}, response.Failures)
+
+		props = inputs("bar", fields{"source": env, "string": "literal"})
+		response, err = prov.Check(p.CheckRequest{Urn: urn("StatefulString"), News: props})
+		require.NoError(t, err)
+		assert.Equal(t, []p.CheckFailure{{Property: "source", Reason: "string and source are mutually exclusive"}}, response.Failures)
+	})
+}